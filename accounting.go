@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UserFormatType string
 
@@ -32,7 +35,12 @@ type AccountingConfig struct {
 // Return
 //  config - Accounting configuration
 func (s *ServerConnection) AccountingGet() (*AccountingConfig, error) {
-	data, err := s.CallRaw("Accounting.get", nil)
+	return s.AccountingGetContext(context.Background())
+}
+
+// AccountingGetContext - AccountingGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AccountingGetContext(ctx context.Context) (*AccountingConfig, error) {
+	data, err := s.CallRawContext(ctx, "Accounting.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -48,10 +56,15 @@ func (s *ServerConnection) AccountingGet() (*AccountingConfig, error) {
 // AccountingSet - Stores Accounting configuration
 //  config - Accounting configuration
 func (s *ServerConnection) AccountingSet(config AccountingConfig) (ErrorList, error) {
+	return s.AccountingSetContext(context.Background(), config)
+}
+
+// AccountingSetContext - AccountingSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AccountingSetContext(ctx context.Context, config AccountingConfig) (ErrorList, error) {
 	params := struct {
 		Config AccountingConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("Accounting.set", params)
+	data, err := s.CallRawContext(ctx, "Accounting.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ConnectionDirection string
 
@@ -46,13 +49,18 @@ type ActiveConnectionList []ActiveConnection
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) ActiveConnectionsGet(query SearchQuery, refresh bool, hostId KId) (ActiveConnectionList, int, error) {
+	return s.ActiveConnectionsGetContext(context.Background(), query, refresh, hostId)
+}
+
+// ActiveConnectionsGetContext - ActiveConnectionsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveConnectionsGetContext(ctx context.Context, query SearchQuery, refresh bool, hostId KId) (ActiveConnectionList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query   SearchQuery `json:"query"`
 		Refresh bool        `json:"refresh"`
 		HostId  KId         `json:"hostId"`
 	}{query, refresh, hostId}
-	data, err := s.CallRaw("ActiveConnections.get", params)
+	data, err := s.CallRawContext(ctx, "ActiveConnections.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -69,9 +77,14 @@ func (s *ServerConnection) ActiveConnectionsGet(query SearchQuery, refresh bool,
 // ActiveConnectionsKill - Kills connections specified in ids list
 //  ids - list of connections id
 func (s *ServerConnection) ActiveConnectionsKill(ids KIdList) error {
+	return s.ActiveConnectionsKillContext(context.Background(), ids)
+}
+
+// ActiveConnectionsKillContext - ActiveConnectionsKill with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveConnectionsKillContext(ctx context.Context, ids KIdList) error {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	_, err := s.CallRaw("ActiveConnections.kill", params)
+	_, err := s.CallRawContext(ctx, "ActiveConnections.kill", params)
 	return err
 }
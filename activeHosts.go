@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ActiveHostType string
 
@@ -89,12 +92,17 @@ type ActivityList []Activity
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) ActiveHostsGet(query SearchQuery, refresh bool) (ActiveHostList, int, error) {
+	return s.ActiveHostsGetContext(context.Background(), query, refresh)
+}
+
+// ActiveHostsGetContext - ActiveHostsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsGetContext(ctx context.Context, query SearchQuery, refresh bool) (ActiveHostList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query   SearchQuery `json:"query"`
 		Refresh bool        `json:"refresh"`
 	}{query, refresh}
-	data, err := s.CallRaw("ActiveHosts.get", params)
+	data, err := s.CallRawContext(ctx, "ActiveHosts.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -113,10 +121,15 @@ func (s *ServerConnection) ActiveHostsGet(query SearchQuery, refresh bool) (Acti
 // Return
 //	list - output data
 func (s *ServerConnection) ActiveHostsGetActivityList(id KId) (ActivityList, error) {
+	return s.ActiveHostsGetActivityListContext(context.Background(), id)
+}
+
+// ActiveHostsGetActivityListContext - ActiveHostsGetActivityList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsGetActivityListContext(ctx context.Context, id KId) (ActivityList, error) {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	data, err := s.CallRaw("ActiveHosts.getActivityList", params)
+	data, err := s.CallRawContext(ctx, "ActiveHosts.getActivityList", params)
 	if err != nil {
 		return nil, err
 	}
@@ -134,11 +147,16 @@ func (s *ServerConnection) ActiveHostsGetActivityList(id KId) (ActivityList, err
 // Return
 //	hist - samples of traffic rate for given host
 func (s *ServerConnection) ActiveHostsGetHistogram(histogramType HistogramType, id KId) (*Histogram, error) {
+	return s.ActiveHostsGetHistogramContext(context.Background(), histogramType, id)
+}
+
+// ActiveHostsGetHistogramContext - ActiveHostsGetHistogram with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsGetHistogramContext(ctx context.Context, histogramType HistogramType, id KId) (*Histogram, error) {
 	params := struct {
 		HistogramType HistogramType `json:"histogramType"`
 		Id            KId           `json:"id"`
 	}{histogramType, id}
-	data, err := s.CallRaw("ActiveHosts.getHistogram", params)
+	data, err := s.CallRawContext(ctx, "ActiveHosts.getHistogram", params)
 	if err != nil {
 		return nil, err
 	}
@@ -158,12 +176,17 @@ func (s *ServerConnection) ActiveHostsGetHistogram(histogramType HistogramType,
 //	hist - output data
 //	sampleTime - Returns first time, that is not included in returned data (pass it to the same method again as a lastSampleTime to obtain only new data since last request)
 func (s *ServerConnection) ActiveHostsGetHistogramInc(histogramIntervalType HistogramIntervalType, id KId, startSampleTime DateTimeStamp) (*Histogram, *DateTimeStamp, error) {
+	return s.ActiveHostsGetHistogramIncContext(context.Background(), histogramIntervalType, id, startSampleTime)
+}
+
+// ActiveHostsGetHistogramIncContext - ActiveHostsGetHistogramInc with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsGetHistogramIncContext(ctx context.Context, histogramIntervalType HistogramIntervalType, id KId, startSampleTime DateTimeStamp) (*Histogram, *DateTimeStamp, error) {
 	params := struct {
 		HistogramIntervalType HistogramIntervalType `json:"histogramIntervalType"`
 		Id                    KId                   `json:"id"`
 		StartSampleTime       DateTimeStamp         `json:"startSampleTime"`
 	}{histogramIntervalType, id, startSampleTime}
-	data, err := s.CallRaw("ActiveHosts.getHistogramInc", params)
+	data, err := s.CallRawContext(ctx, "ActiveHosts.getHistogramInc", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,10 +203,15 @@ func (s *ServerConnection) ActiveHostsGetHistogramInc(histogramIntervalType Hist
 // ActiveHostsLogout - Logout users from specified hosts / empty for all hosts
 //	ids - Active Host ids returned by get or empty for logout all hosts
 func (s *ServerConnection) ActiveHostsLogout(ids KIdList) error {
+	return s.ActiveHostsLogoutContext(context.Background(), ids)
+}
+
+// ActiveHostsLogoutContext - ActiveHostsLogout with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsLogoutContext(ctx context.Context, ids KIdList) error {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	_, err := s.CallRaw("ActiveHosts.logout", params)
+	_, err := s.CallRawContext(ctx, "ActiveHosts.logout", params)
 	return err
 }
 
@@ -191,10 +219,15 @@ func (s *ServerConnection) ActiveHostsLogout(ids KIdList) error {
 //	hostId - internal identifier of a host computer in network
 //	userName - Name of a user to be loged from given host specified by hostId (including domain if needed)
 func (s *ServerConnection) ActiveHostsLogin(hostId KId, userName string) error {
+	return s.ActiveHostsLoginContext(context.Background(), hostId, userName)
+}
+
+// ActiveHostsLoginContext - ActiveHostsLogin with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ActiveHostsLoginContext(ctx context.Context, hostId KId, userName string) error {
 	params := struct {
 		HostId   KId    `json:"hostId"`
 		UserName string `json:"userName"`
 	}{hostId, userName}
-	_, err := s.CallRaw("ActiveHosts.login", params)
+	_, err := s.CallRawContext(ctx, "ActiveHosts.login", params)
 	return err
 }
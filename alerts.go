@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type AlertRow struct {
 	Id      KId    `json:"id"`
@@ -60,11 +63,16 @@ type AlertSettingList []AlertSetting
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) AlertsGet(query SearchQuery) (AlertRowList, int, error) {
+	return s.AlertsGetContext(context.Background(), query)
+}
+
+// AlertsGetContext - AlertsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsGetContext(ctx context.Context, query SearchQuery) (AlertRowList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("Alerts.get", params)
+	data, err := s.CallRawContext(ctx, "Alerts.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -83,10 +91,15 @@ func (s *ServerConnection) AlertsGet(query SearchQuery) (AlertRowList, int, erro
 // Return
 //	content - output data
 func (s *ServerConnection) AlertsGetContent(id KId) (string, error) {
+	return s.AlertsGetContentContext(context.Background(), id)
+}
+
+// AlertsGetContentContext - AlertsGetContent with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsGetContentContext(ctx context.Context, id KId) (string, error) {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	data, err := s.CallRaw("Alerts.getContent", params)
+	data, err := s.CallRawContext(ctx, "Alerts.getContent", params)
 	if err != nil {
 		return "", err
 	}
@@ -103,7 +116,12 @@ func (s *ServerConnection) AlertsGetContent(id KId) (string, error) {
 // Return
 //  types - list of possible Alerts types
 func (s *ServerConnection) AlertsGetAlertTypes() (AlertTypeList, error) {
-	data, err := s.CallRaw("Alerts.getAlertTypes", nil)
+	return s.AlertsGetAlertTypesContext(context.Background())
+}
+
+// AlertsGetAlertTypesContext - AlertsGetAlertTypes with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsGetAlertTypesContext(ctx context.Context) (AlertTypeList, error) {
+	data, err := s.CallRawContext(ctx, "Alerts.getAlertTypes", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +138,12 @@ func (s *ServerConnection) AlertsGetAlertTypes() (AlertTypeList, error) {
 // Return
 //  config - list of user defined alert handling
 func (s *ServerConnection) AlertsGetSettings() (AlertSettingList, error) {
-	data, err := s.CallRaw("Alerts.getSettings", nil)
+	return s.AlertsGetSettingsContext(context.Background())
+}
+
+// AlertsGetSettingsContext - AlertsGetSettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsGetSettingsContext(ctx context.Context) (AlertSettingList, error) {
+	data, err := s.CallRawContext(ctx, "Alerts.getSettings", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -138,10 +161,15 @@ func (s *ServerConnection) AlertsGetSettings() (AlertSettingList, error) {
 // Return
 //	errors - list of items that cannot be changed.
 func (s *ServerConnection) AlertsSetSettings(config AlertSettingList) (ErrorList, error) {
+	return s.AlertsSetSettingsContext(context.Background(), config)
+}
+
+// AlertsSetSettingsContext - AlertsSetSettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsSetSettingsContext(ctx context.Context, config AlertSettingList) (ErrorList, error) {
 	params := struct {
 		Config AlertSettingList `json:"config"`
 	}{config}
-	data, err := s.CallRaw("Alerts.setSettings", params)
+	data, err := s.CallRawContext(ctx, "Alerts.setSettings", params)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +186,12 @@ func (s *ServerConnection) AlertsSetSettings(config AlertSettingList) (ErrorList
 // Return
 //  lang - default language for Alert emails
 func (s *ServerConnection) AlertsGetDefaultLanguage() (string, error) {
-	data, err := s.CallRaw("Alerts.getDefaultLanguage", nil)
+	return s.AlertsGetDefaultLanguageContext(context.Background())
+}
+
+// AlertsGetDefaultLanguageContext - AlertsGetDefaultLanguage with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsGetDefaultLanguageContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "Alerts.getDefaultLanguage", nil)
 	if err != nil {
 		return "", err
 	}
@@ -174,9 +207,14 @@ func (s *ServerConnection) AlertsGetDefaultLanguage() (string, error) {
 // AlertsSetDefaultLanguage - Stores default language for Alert emails
 //  lang - default language for Alert emails
 func (s *ServerConnection) AlertsSetDefaultLanguage(lang string) error {
+	return s.AlertsSetDefaultLanguageContext(context.Background(), lang)
+}
+
+// AlertsSetDefaultLanguageContext - AlertsSetDefaultLanguage with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AlertsSetDefaultLanguageContext(ctx context.Context, lang string) error {
 	params := struct {
 		Lang string `json:"lang"`
 	}{lang}
-	_, err := s.CallRaw("Alerts.setDefaultLanguage", params)
+	_, err := s.CallRawContext(ctx, "Alerts.setDefaultLanguage", params)
 	return err
 }
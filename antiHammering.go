@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type AntiHammeringConfig struct {
 	Enabled          bool        `json:"enabled"`
@@ -11,16 +14,26 @@ type AntiHammeringConfig struct {
 
 // AntiHammeringSet -
 func (s *ServerConnection) AntiHammeringSet(config AntiHammeringConfig) error {
+	return s.AntiHammeringSetContext(context.Background(), config)
+}
+
+// AntiHammeringSetContext - AntiHammeringSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntiHammeringSetContext(ctx context.Context, config AntiHammeringConfig) error {
 	params := struct {
 		Config AntiHammeringConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("AntiHammering.set", params)
+	_, err := s.CallRawContext(ctx, "AntiHammering.set", params)
 	return err
 }
 
 // AntiHammeringGet -
 func (s *ServerConnection) AntiHammeringGet() (*AntiHammeringConfig, error) {
-	data, err := s.CallRaw("AntiHammering.get", nil)
+	return s.AntiHammeringGetContext(context.Background())
+}
+
+// AntiHammeringGetContext - AntiHammeringGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntiHammeringGetContext(ctx context.Context) (*AntiHammeringConfig, error) {
+	data, err := s.CallRawContext(ctx, "AntiHammering.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +48,12 @@ func (s *ServerConnection) AntiHammeringGet() (*AntiHammeringConfig, error) {
 
 // AntiHammeringGetBlockedIpCount -
 func (s *ServerConnection) AntiHammeringGetBlockedIpCount() (int, error) {
-	data, err := s.CallRaw("AntiHammering.getBlockedIpCount", nil)
+	return s.AntiHammeringGetBlockedIpCountContext(context.Background())
+}
+
+// AntiHammeringGetBlockedIpCountContext - AntiHammeringGetBlockedIpCount with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntiHammeringGetBlockedIpCountContext(ctx context.Context) (int, error) {
+	data, err := s.CallRawContext(ctx, "AntiHammering.getBlockedIpCount", nil)
 	if err != nil {
 		return 0, err
 	}
@@ -50,6 +68,11 @@ func (s *ServerConnection) AntiHammeringGetBlockedIpCount() (int, error) {
 
 // AntiHammeringUnblockAll -
 func (s *ServerConnection) AntiHammeringUnblockAll() error {
-	_, err := s.CallRaw("AntiHammering.unblockAll", nil)
+	return s.AntiHammeringUnblockAllContext(context.Background())
+}
+
+// AntiHammeringUnblockAllContext - AntiHammeringUnblockAll with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntiHammeringUnblockAllContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "AntiHammering.unblockAll", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // AntivirusOption - Common part, that can be shared between the products
 type AntivirusOption struct {
@@ -126,7 +129,12 @@ type AntivirusConfig struct {
 // Return
 //  config - Antivirus Settings
 func (s *ServerConnection) AntivirusGet() (*AntivirusConfig, error) {
-	data, err := s.CallRaw("Antivirus.get", nil)
+	return s.AntivirusGetContext(context.Background())
+}
+
+// AntivirusGetContext - AntivirusGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntivirusGetContext(ctx context.Context) (*AntivirusConfig, error) {
+	data, err := s.CallRawContext(ctx, "Antivirus.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,10 +152,15 @@ func (s *ServerConnection) AntivirusGet() (*AntivirusConfig, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) AntivirusSet(config AntivirusConfig) (ErrorList, error) {
+	return s.AntivirusSetContext(context.Background(), config)
+}
+
+// AntivirusSetContext - AntivirusSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntivirusSetContext(ctx context.Context, config AntivirusConfig) (ErrorList, error) {
 	params := struct {
 		Config AntivirusConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("Antivirus.set", params)
+	data, err := s.CallRawContext(ctx, "Antivirus.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +175,12 @@ func (s *ServerConnection) AntivirusSet(config AntivirusConfig) (ErrorList, erro
 
 // AntivirusUpdate - Force update of integrated antivirus
 func (s *ServerConnection) AntivirusUpdate() error {
-	_, err := s.CallRaw("Antivirus.update", nil)
+	return s.AntivirusUpdateContext(context.Background())
+}
+
+// AntivirusUpdateContext - AntivirusUpdate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntivirusUpdateContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Antivirus.update", nil)
 	return err
 }
 
@@ -170,7 +188,12 @@ func (s *ServerConnection) AntivirusUpdate() error {
 // Return
 //  status - progress of antivirus updating
 func (s *ServerConnection) AntivirusGetUpdateStatus() (*InternalUpdateStatus, error) {
-	data, err := s.CallRaw("Antivirus.getUpdateStatus", nil)
+	return s.AntivirusGetUpdateStatusContext(context.Background())
+}
+
+// AntivirusGetUpdateStatusContext - AntivirusGetUpdateStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AntivirusGetUpdateStatusContext(ctx context.Context) (*InternalUpdateStatus, error) {
+	data, err := s.CallRawContext(ctx, "Antivirus.getUpdateStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type HttpProxyAuth struct {
 	Enabled      bool           `json:"enabled"`
@@ -44,7 +47,12 @@ const (
 // Return
 //	config - configuration values
 func (s *ServerConnection) AuthenticationGet() (*AuthenticationConfig, error) {
-	data, err := s.CallRaw("Authentication.get", nil)
+	return s.AuthenticationGetContext(context.Background())
+}
+
+// AuthenticationGetContext - AuthenticationGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationGetContext(ctx context.Context) (*AuthenticationConfig, error) {
+	data, err := s.CallRawContext(ctx, "Authentication.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +68,15 @@ func (s *ServerConnection) AuthenticationGet() (*AuthenticationConfig, error) {
 // AuthenticationSet - Stores Authentication option settings
 //	config - configuration values
 func (s *ServerConnection) AuthenticationSet(config AuthenticationConfig) error {
+	return s.AuthenticationSetContext(context.Background(), config)
+}
+
+// AuthenticationSetContext - AuthenticationSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationSetContext(ctx context.Context, config AuthenticationConfig) error {
 	params := struct {
 		Config AuthenticationConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("Authentication.set", params)
+	_, err := s.CallRawContext(ctx, "Authentication.set", params)
 	return err
 }
 
@@ -76,13 +89,18 @@ func (s *ServerConnection) AuthenticationSet(config AuthenticationConfig) error
 //	message - text related to join result
 //	status - current status
 func (s *ServerConnection) AuthenticationJoin(hostName string, domainName string, credentials CredentialsConfig, server string) (*LocalizableMessage, *JoinStatus, error) {
+	return s.AuthenticationJoinContext(context.Background(), hostName, domainName, credentials, server)
+}
+
+// AuthenticationJoinContext - AuthenticationJoin with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationJoinContext(ctx context.Context, hostName string, domainName string, credentials CredentialsConfig, server string) (*LocalizableMessage, *JoinStatus, error) {
 	params := struct {
 		HostName    string            `json:"hostName"`
 		DomainName  string            `json:"domainName"`
 		Credentials CredentialsConfig `json:"credentials"`
 		Server      string            `json:"server"`
 	}{hostName, domainName, credentials, server}
-	data, err := s.CallRaw("Authentication.join", params)
+	data, err := s.CallRawContext(ctx, "Authentication.join", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,10 +119,15 @@ func (s *ServerConnection) AuthenticationJoin(hostName string, domainName string
 // Return
 //	needServer - true - join must have param server.enabled on true and server.value filled
 func (s *ServerConnection) AuthenticationIsJoinServerNeeded(domainName string) (bool, error) {
+	return s.AuthenticationIsJoinServerNeededContext(context.Background(), domainName)
+}
+
+// AuthenticationIsJoinServerNeededContext - AuthenticationIsJoinServerNeeded with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationIsJoinServerNeededContext(ctx context.Context, domainName string) (bool, error) {
 	params := struct {
 		DomainName string `json:"domainName"`
 	}{domainName}
-	data, err := s.CallRaw("Authentication.isJoinServerNeeded", params)
+	data, err := s.CallRawContext(ctx, "Authentication.isJoinServerNeeded", params)
 	if err != nil {
 		return false, err
 	}
@@ -123,10 +146,15 @@ func (s *ServerConnection) AuthenticationIsJoinServerNeeded(domainName string) (
 //	message - text related to leave result
 //	status - current status
 func (s *ServerConnection) AuthenticationLeave(credentials CredentialsConfig) (*LocalizableMessage, *JoinStatus, error) {
+	return s.AuthenticationLeaveContext(context.Background(), credentials)
+}
+
+// AuthenticationLeaveContext - AuthenticationLeave with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationLeaveContext(ctx context.Context, credentials CredentialsConfig) (*LocalizableMessage, *JoinStatus, error) {
 	params := struct {
 		Credentials CredentialsConfig `json:"credentials"`
 	}{credentials}
-	data, err := s.CallRaw("Authentication.leave", params)
+	data, err := s.CallRawContext(ctx, "Authentication.leave", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -145,7 +173,12 @@ func (s *ServerConnection) AuthenticationLeave(credentials CredentialsConfig) (*
 //	status - current status
 //	domainName - a string representation of joined domain.
 func (s *ServerConnection) AuthenticationGetJoinStatus() (*JoinStatus, string, error) {
-	data, err := s.CallRaw("Authentication.getJoinStatus", nil)
+	return s.AuthenticationGetJoinStatusContext(context.Background())
+}
+
+// AuthenticationGetJoinStatusContext - AuthenticationGetJoinStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationGetJoinStatusContext(ctx context.Context) (*JoinStatus, string, error) {
+	data, err := s.CallRawContext(ctx, "Authentication.getJoinStatus", nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -163,7 +196,12 @@ func (s *ServerConnection) AuthenticationGetJoinStatus() (*JoinStatus, string, e
 // Return
 //	config - configuration values
 func (s *ServerConnection) AuthenticationGetTotpConfig() (*TotpConfig, error) {
-	data, err := s.CallRaw("Authentication.getTotpConfig", nil)
+	return s.AuthenticationGetTotpConfigContext(context.Background())
+}
+
+// AuthenticationGetTotpConfigContext - AuthenticationGetTotpConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationGetTotpConfigContext(ctx context.Context) (*TotpConfig, error) {
+	data, err := s.CallRawContext(ctx, "Authentication.getTotpConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -179,9 +217,14 @@ func (s *ServerConnection) AuthenticationGetTotpConfig() (*TotpConfig, error) {
 // AuthenticationSetTotpConfig - Stores TotpConfig
 //	config - configuration values
 func (s *ServerConnection) AuthenticationSetTotpConfig(config TotpConfig) error {
+	return s.AuthenticationSetTotpConfigContext(context.Background(), config)
+}
+
+// AuthenticationSetTotpConfigContext - AuthenticationSetTotpConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) AuthenticationSetTotpConfigContext(ctx context.Context, config TotpConfig) error {
 	params := struct {
 		Config TotpConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("Authentication.setTotpConfig", params)
+	_, err := s.CallRawContext(ctx, "Authentication.setTotpConfig", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type BMConditionType string
 
@@ -106,7 +109,12 @@ type BandwidthManagementConfig struct {
 // Return
 //	config - Bandwidth Management rules
 func (s *ServerConnection) BandwidthManagementGet() (*BandwidthManagementConfig, error) {
-	data, err := s.CallRaw("BandwidthManagement.get", nil)
+	return s.BandwidthManagementGetContext(context.Background())
+}
+
+// BandwidthManagementGetContext - BandwidthManagementGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) BandwidthManagementGetContext(ctx context.Context) (*BandwidthManagementConfig, error) {
+	data, err := s.CallRawContext(ctx, "BandwidthManagement.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,10 +132,15 @@ func (s *ServerConnection) BandwidthManagementGet() (*BandwidthManagementConfig,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) BandwidthManagementSet(config BandwidthManagementConfig) (ErrorList, error) {
+	return s.BandwidthManagementSetContext(context.Background(), config)
+}
+
+// BandwidthManagementSetContext - BandwidthManagementSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) BandwidthManagementSetContext(ctx context.Context, config BandwidthManagementConfig) (ErrorList, error) {
 	params := struct {
 		Config BandwidthManagementConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("BandwidthManagement.set", params)
+	data, err := s.CallRawContext(ctx, "BandwidthManagement.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +157,12 @@ func (s *ServerConnection) BandwidthManagementSet(config BandwidthManagementConf
 // Return
 //	list - list of interfaces (sorted by name); empty if there are no Internet interfaces
 func (s *ServerConnection) BandwidthManagementGetBandwidth() (InternetBandwidthList, error) {
-	data, err := s.CallRaw("BandwidthManagement.getBandwidth", nil)
+	return s.BandwidthManagementGetBandwidthContext(context.Background())
+}
+
+// BandwidthManagementGetBandwidthContext - BandwidthManagementGetBandwidth with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) BandwidthManagementGetBandwidthContext(ctx context.Context) (InternetBandwidthList, error) {
+	data, err := s.CallRawContext(ctx, "BandwidthManagement.getBandwidth", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -162,10 +180,15 @@ func (s *ServerConnection) BandwidthManagementGetBandwidth() (InternetBandwidthL
 // Return
 //	errors - list of errors
 func (s *ServerConnection) BandwidthManagementSetBandwidth(list InternetBandwidthList) (ErrorList, error) {
+	return s.BandwidthManagementSetBandwidthContext(context.Background(), list)
+}
+
+// BandwidthManagementSetBandwidthContext - BandwidthManagementSetBandwidth with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) BandwidthManagementSetBandwidthContext(ctx context.Context, list InternetBandwidthList) (ErrorList, error) {
 	params := struct {
 		List InternetBandwidthList `json:"list"`
 	}{list}
-	data, err := s.CallRaw("BandwidthManagement.setBandwidth", params)
+	data, err := s.CallRawContext(ctx, "BandwidthManagement.setBandwidth", params)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,324 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Batch - calls sent to the server together as one JSON-RPC 2.0 batch, saving round trips.
+// If the server rejects the batch, the calls are sent one by one.
+// Every batched call passes through the middlewares added by Use on its own, concurrently with the other
+// calls, only the innermost round trip sends it together with them. Therefore a middleware must not make
+// a call wait until another call returns (e.g. by a mutex held over next.RoundTrip), the batch would wait
+// until ctx of Send is done. A call repeated by a middleware (e.g. by Retry) after the batch was sent
+// is sent alone, as well as calls of methods with own limits (see Limits.Methods) or timeout (see SetMethodTimeout).
+// Batch is not safe for concurrent use.
+type Batch struct {
+	s     *ServerConnection
+	calls []*BatchCall
+}
+
+// BatchCall - call queued in Batch; Err and Data are filled by Batch.Send
+type BatchCall struct {
+	Method string
+	Params interface{}
+	Err    error  // error of the call reported by the server
+	Data   []byte // raw response of the call, as returned by CallRaw
+	result interface{}
+}
+
+type batchResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+}
+
+// batchKey - key of *batchSlot in the context of a batched call
+type batchKey struct{}
+
+// batchSender - sender of calls of one Batch.Send, collecting them at the innermost round trip
+type batchSender struct {
+	s       *ServerConnection
+	ctx     context.Context
+	mu      sync.Mutex
+	pending int          // count of calls neither arrived at the innermost round trip nor finished
+	queue   []*batchSlot // calls arrived at the innermost round trip
+	sent    bool
+	err     error // error of sending of the whole batch
+	// sequential - sends calls one by one if batches are not supported by the server
+	sequential semaphore
+}
+
+// batchSlot - batched call on its way through the middleware chain
+type batchSlot struct {
+	sender      *batchSender
+	call        *BatchCall
+	resolved    bool          // arrived at the innermost round trip or finished, guarded by sender.mu
+	done        chan struct{} // closed when the response is known
+	id          int           // request id of the call in the batch
+	data        []byte
+	err         error
+	unsupported bool // batches are not supported by the server, the call has to be sent alone
+}
+
+// NewBatch returns an empty batch of calls
+func (s *ServerConnection) NewBatch() *Batch {
+	return &Batch{s: s}
+}
+
+// Add queues call of method with params.
+//	result - pointer to value the "result" member of the response is decoded into, may be nil.
+//		E.g. for "ProductInfo.get" it may be &struct{ ProductInfo ProductInfo `json:"productInfo"` }{}
+func (b *Batch) Add(method string, params interface{}, result interface{}) *BatchCall {
+	call := &BatchCall{
+		Method: method,
+		Params: params,
+		result: result,
+	}
+	b.calls = append(b.calls, call)
+	return call
+}
+
+// Len returns count of queued calls
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Send sends all queued calls and fills results and errors of them. The returned error is set
+// only when the batch could not be sent at all, errors of particular calls are in BatchCall.Err.
+func (b *Batch) Send(ctx context.Context) error {
+	if len(b.calls) == 0 {
+		return nil
+	}
+	sender := &batchSender{
+		s:          b.s,
+		ctx:        ctx,
+		pending:    len(b.calls),
+		sequential: semaphore{size: 1},
+	}
+	var wg sync.WaitGroup
+	for _, call := range b.calls {
+		slot := &batchSlot{
+			sender: sender,
+			call:   call,
+			done:   make(chan struct{}),
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			call := slot.call
+			call.Data, call.Err = b.s.CallRawContext(context.WithValue(ctx, batchKey{}, slot), call.Method, call.Params)
+			sender.finished(slot)
+			if call.Err == nil {
+				response := batchResponse{}
+				if call.Err = json.Unmarshal(call.Data, &response); call.Err == nil {
+					call.Err = call.decode(response.Result)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return sender.err
+}
+
+// send sends the call alone or, if it is a batched call arriving for the first time, together with the batch
+func (s *ServerConnection) send(ctx context.Context, method string, token *string, params interface{}) ([]byte, error) {
+	slot, ok := ctx.Value(batchKey{}).(*batchSlot)
+	if !ok || slot.sender.s != s {
+		return s.call(ctx, method, token, params)
+	}
+	if s.Config.hasMethodOptions(method) {
+		// limits and timeout of the method apply to the call sent alone, the batch does not wait for it
+		slot.sender.finished(slot)
+		return s.call(ctx, method, token, params)
+	}
+	if !slot.sender.enqueue(slot) {
+		return s.call(ctx, method, token, params)
+	}
+	select {
+	case <-slot.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if slot.id != 0 {
+		setCallID(ctx, slot.id)
+		setCallResponseSize(ctx, len(slot.data))
+	}
+	if slot.err != nil {
+		return nil, slot.err
+	}
+	if !slot.unsupported {
+		return slot.data, nil
+	}
+	// batches are not supported by the server, the calls are sent one by one
+	sequential := &slot.sender.sequential
+	if err := sequential.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer sequential.release()
+	return s.call(ctx, method, token, params)
+}
+
+// enqueue adds the call arrived at the innermost round trip to the batch and sends the batch
+// if no other call is expected, false is returned if the call has to be sent alone
+func (b *batchSender) enqueue(slot *batchSlot) bool {
+	b.mu.Lock()
+	if b.sent || slot.resolved {
+		b.mu.Unlock()
+		return false
+	}
+	slot.resolved = true
+	b.queue = append(b.queue, slot)
+	b.resolve()
+	return true
+}
+
+// finished marks the call as finished, e.g. when a middleware answered it without sending it
+func (b *batchSender) finished(slot *batchSlot) {
+	b.mu.Lock()
+	if slot.resolved {
+		b.mu.Unlock()
+		return
+	}
+	slot.resolved = true
+	b.resolve()
+}
+
+// resolve counts one resolved call and sends the batch when no other call is expected, b.mu must be locked
+func (b *batchSender) resolve() {
+	b.pending--
+	if b.pending > 0 || b.sent {
+		b.mu.Unlock()
+		return
+	}
+	b.sent = true
+	queue := b.queue
+	b.mu.Unlock()
+	b.flush(queue)
+}
+
+// flush sends the queued calls as one request and hands the responses over to them
+func (b *batchSender) flush(queue []*batchSlot) {
+	if len(queue) == 0 {
+		return
+	}
+	defer func() {
+		for _, slot := range queue {
+			close(slot.done)
+		}
+	}()
+	s := b.s
+	token := s.token()
+	requests := make([]parameters, len(queue))
+	byID := make(map[int]*batchSlot, len(queue))
+	for i, slot := range queue {
+		id := s.Config.getID()
+		requests[i] = parameters{
+			JsonRpc: "2.0",
+			Method:  slot.call.Method,
+			ID:      id,
+			Token:   token,
+			Params:  slot.call.Params,
+		}
+		byID[id] = slot
+		slot.id = id
+	}
+	fail := func(err error) {
+		for _, slot := range queue {
+			slot.err = err
+		}
+		b.err = err
+	}
+	buffer, err := json.Marshal(requests)
+	if err != nil {
+		fail(err)
+		return
+	}
+	// sizes of particular requests for metrics
+	sizes := make(map[int]int, len(requests))
+	if s.metrics != nil {
+		for _, request := range requests {
+			if data, err := json.Marshal(request); err == nil {
+				sizes[request.ID] = len(data)
+			}
+		}
+	}
+	release, err := s.Config.acquire(b.ctx, "")
+	if err != nil {
+		fail(err)
+		return
+	}
+	start := time.Now()
+	postCtx, cancel := s.Config.withTimeout(b.ctx, "")
+	data, err := s.post(postCtx, token, buffer)
+	cancel()
+	release()
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		// batches are rejected by the server or by a proxy in front of it
+		b.unsupported(queue)
+		return
+	}
+	if err != nil {
+		for _, request := range requests {
+			s.log(request.Method, request.ID, start, request.Params, nil, err)
+			s.observe(request.Method, start, sizes[request.ID], 0, err)
+		}
+		fail(err)
+		return
+	}
+	var responses []json.RawMessage
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) || json.Unmarshal(data, &responses) != nil {
+		// batches are not supported by the server
+		b.unsupported(queue)
+		return
+	}
+	for _, raw := range responses {
+		response := batchResponse{}
+		if err = json.Unmarshal(raw, &response); err != nil {
+			fail(err)
+			return
+		}
+		slot, ok := byID[response.ID]
+		if !ok {
+			continue
+		}
+		delete(byID, response.ID)
+		slot.data = raw
+		slot.err = checkError(raw)
+		s.log(slot.call.Method, response.ID, start, slot.call.Params, raw, slot.err)
+		s.observe(slot.call.Method, start, sizes[response.ID], len(raw), slot.err)
+	}
+	for _, slot := range byID {
+		slot.err = fmt.Errorf("no response to %s in batch", slot.call.Method)
+	}
+}
+
+// hasMethodOptions reports whether limits or timeout of method are set, which do not apply to batches
+func (c *Config) hasMethodOptions(method string) bool {
+	if c.limiter != nil {
+		if _, ok := c.limiter.methods[method]; ok {
+			return true
+		}
+	}
+	_, ok := c.transport.methodTimeouts[method]
+	return ok
+}
+
+// unsupported makes the queued calls to be sent one by one
+func (b *batchSender) unsupported(queue []*batchSlot) {
+	for _, slot := range queue {
+		slot.unsupported = true
+	}
+}
+
+func (c *BatchCall) decode(result json.RawMessage) error {
+	if c.result == nil || len(result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(result, c.result)
+}
@@ -0,0 +1,283 @@
+package control_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// batchServer - server answering every method by its name, except methods starting with "Fail."
+type batchServer struct {
+	srv      *httptest.Server
+	mu       sync.Mutex
+	requests int            // count of HTTP requests
+	calls    map[string]int // count of calls by method
+	// batches - how batches are rejected: "" accepts them, "status" responds 400, "object" responds a single error
+	batches string
+	// active and maxActive - count of requests being served and its maximum
+	active, maxActive int32
+}
+
+type rpcRequest struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+}
+
+func newBatchServer(t *testing.T, batches string) (*batchServer, *control.ServerConnection) {
+	s := &batchServer{calls: map[string]int{}, batches: batches}
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.srv.Close)
+	config := control.NewConfig(strings.TrimPrefix(s.srv.URL, "https://"))
+	if err := config.AddRootCAs(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.srv.Certificate().Raw})); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := config.NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, conn
+}
+
+func (s *batchServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	active := atomic.AddInt32(&s.active, 1)
+	defer atomic.AddInt32(&s.active, -1)
+	for max := atomic.LoadInt32(&s.maxActive); active > max && !atomic.CompareAndSwapInt32(&s.maxActive, max, active); {
+		max = atomic.LoadInt32(&s.maxActive)
+	}
+	// concurrent requests overlap
+	time.Sleep(10 * time.Millisecond)
+	body, _ := ioutil.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if strings.HasPrefix(string(body), "[") {
+		switch s.batches {
+		case "status":
+			http.Error(w, "batches not allowed", http.StatusBadRequest)
+			return
+		case "object":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":0,"error":{"code":-32600,"message":"Invalid request"}}`))
+			return
+		}
+		var requests []rpcRequest
+		_ = json.Unmarshal(body, &requests)
+		responses := make([]interface{}, len(requests))
+		for i, request := range requests {
+			responses[i] = s.respond(request)
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+		return
+	}
+	request := rpcRequest{}
+	_ = json.Unmarshal(body, &request)
+	_ = json.NewEncoder(w).Encode(s.respond(request))
+}
+
+func (s *batchServer) respond(request rpcRequest) interface{} {
+	s.calls[request.Method]++
+	if strings.HasPrefix(request.Method, "Fail.") || (request.Method == "Flaky.get" && s.calls[request.Method] == 1) {
+		return map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.ID,
+			"error":   map[string]interface{}{"code": control.CodeOperationFailed, "message": "failed"},
+		}
+	}
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      request.ID,
+		"result":  map[string]string{"method": request.Method},
+	}
+}
+
+type methodResult struct {
+	Method string `json:"method"`
+}
+
+func TestBatchPassesMiddlewares(t *testing.T) {
+	server, conn := newBatchServer(t, "")
+	var mu sync.Mutex
+	var seen []string
+	conn.Use(func(next control.RoundTripper) control.RoundTripper {
+		return control.RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			mu.Lock()
+			seen = append(seen, method)
+			mu.Unlock()
+			if method == "Cached.get" {
+				// answered without the server
+				return []byte(`{"jsonrpc":"2.0","id":0,"result":{"method":"cached"}}`), nil
+			}
+			return next.RoundTrip(ctx, method, params)
+		})
+	})
+	batch := conn.NewBatch()
+	results := make([]methodResult, 4)
+	calls := []*control.BatchCall{
+		batch.Add("A.get", nil, &results[0]),
+		batch.Add("B.get", nil, &results[1]),
+		batch.Add("Cached.get", nil, &results[2]),
+		batch.Add("Fail.get", nil, &results[3]),
+	}
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if server.requests != 1 {
+		t.Errorf("got %d HTTP requests, expected 1", server.requests)
+	}
+	if len(seen) != 4 {
+		t.Errorf("middleware saw %v, expected all 4 calls", seen)
+	}
+	for i, expected := range []string{"A.get", "B.get", "cached"} {
+		if calls[i].Err != nil || results[i].Method != expected {
+			t.Errorf("call %d: got %q, %v, expected %q", i, results[i].Method, calls[i].Err, expected)
+		}
+	}
+	var apiErr *control.APIError
+	if !errors.As(calls[3].Err, &apiErr) || apiErr.Code != control.CodeOperationFailed {
+		t.Errorf("got error %v, expected code %d", calls[3].Err, control.CodeOperationFailed)
+	}
+}
+
+func TestBatchRepeatedCallSentAlone(t *testing.T) {
+	server, conn := newBatchServer(t, "")
+	// repeats failed calls once
+	conn.Use(func(next control.RoundTripper) control.RoundTripper {
+		return control.RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			data, err := next.RoundTrip(ctx, method, params)
+			if err != nil {
+				return next.RoundTrip(ctx, method, params)
+			}
+			return data, err
+		})
+	})
+	batch := conn.NewBatch()
+	result := methodResult{}
+	call := batch.Add("Flaky.get", nil, &result)
+	batch.Add("A.get", nil, nil)
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if call.Err != nil || result.Method != "Flaky.get" {
+		t.Errorf("got %q, %v", result.Method, call.Err)
+	}
+	if server.requests != 2 || server.calls["Flaky.get"] != 2 {
+		t.Errorf("got %d HTTP requests and %d calls of Flaky.get, expected 2 and 2", server.requests, server.calls["Flaky.get"])
+	}
+}
+
+func TestBatchFallback(t *testing.T) {
+	for _, batches := range []string{"status", "object"} {
+		server, conn := newBatchServer(t, batches)
+		batch := conn.NewBatch()
+		results := make([]methodResult, 3)
+		calls := []*control.BatchCall{
+			batch.Add("A.get", nil, &results[0]),
+			batch.Add("B.get", nil, &results[1]),
+			batch.Add("C.get", nil, &results[2]),
+		}
+		if err := batch.Send(context.Background()); err != nil {
+			t.Fatalf("%s: %v", batches, err)
+		}
+		for i, expected := range []string{"A.get", "B.get", "C.get"} {
+			if calls[i].Err != nil || results[i].Method != expected {
+				t.Errorf("%s: call %d: got %q, %v, expected %q", batches, i, results[i].Method, calls[i].Err, expected)
+			}
+		}
+		if server.requests != 4 {
+			t.Errorf("%s: got %d HTTP requests, expected the batch and 3 calls", batches, server.requests)
+		}
+		if atomic.LoadInt32(&server.maxActive) != 1 {
+			t.Errorf("%s: got %d concurrent requests, expected the calls sent one by one", batches, server.maxActive)
+		}
+	}
+}
+
+func TestBatchRelogin(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.SetRelogin(control.StaticCredentials(controltest.UserName, controltest.Password), nil)
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	fake.ExpireSessions()
+	batch := conn.NewBatch()
+	names := make([]struct {
+		Name string `json:"name"`
+	}, 3)
+	var calls []*control.BatchCall
+	for i := range names {
+		calls = append(calls, batch.Add("Session.getUserName", nil, &names[i]))
+	}
+	if err = batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for i, call := range calls {
+		if call.Err != nil || names[i].Name != controltest.UserName {
+			t.Errorf("call %d: got %q, %v", i, names[i].Name, call.Err)
+		}
+	}
+}
+
+func TestBatchSerializingMiddleware(t *testing.T) {
+	_, conn := newBatchServer(t, "")
+	// holding the mutex over the round trip makes the calls wait for each other, see Batch
+	var mu sync.Mutex
+	conn.Use(func(next control.RoundTripper) control.RoundTripper {
+		return control.RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return next.RoundTrip(ctx, method, params)
+		})
+	})
+	batch := conn.NewBatch()
+	first := batch.Add("A.get", nil, nil)
+	batch.Add("B.get", nil, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := batch.Send(ctx)
+	if !errors.Is(first.Err, context.DeadlineExceeded) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, %v, expected the batch to wait until the deadline", first.Err, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("send returned after %s", elapsed)
+	}
+}
+
+func TestBatchMethodOptions(t *testing.T) {
+	server, conn := newBatchServer(t, "")
+	conn.Config.SetLimits(control.Limits{Methods: map[string]control.Limits{"Logs.get": {MaxInFlight: 1}}})
+	conn.Config.SetMethodTimeout("Notifications.get", time.Minute)
+	batch := conn.NewBatch()
+	results := make([]methodResult, 4)
+	var calls []*control.BatchCall
+	for i, method := range []string{"A.get", "Logs.get", "Notifications.get", "B.get"} {
+		calls = append(calls, batch.Add(method, nil, &results[i]))
+	}
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for i, call := range calls {
+		if call.Err != nil || results[i].Method != call.Method {
+			t.Errorf("got %q, %v, expected %q", results[i].Method, call.Err, call.Method)
+		}
+	}
+	if server.requests != 3 {
+		t.Errorf("got %d HTTP requests, expected the batch and 2 calls sent alone", server.requests)
+	}
+}
@@ -0,0 +1,145 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+// Cassette - JSON-RPC exchanges recorded from a real server and replayed in tests.
+// Passwords, tokens and other secrets are scrubbed from the recorded params and responses.
+//	cassette := control.NewCassette()
+//	conn.Use(cassette.Record())
+//	... calls of the server ...
+//	err = cassette.Save("testdata/users.json")
+// and in tests
+//	cassette, err := control.LoadCassette("testdata/users.json")
+//	conn.Use(cassette.Replay())
+type Cassette struct {
+	mu           sync.Mutex
+	Interactions []Interaction `json:"interactions"`
+	used         []bool
+}
+
+// Interaction - one recorded call
+type Interaction struct {
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"` // raw response, if the call succeeded
+	Error    *ErrorReport    `json:"error,omitempty"`    // error reported by the server
+	Failure  string          `json:"failure,omitempty"`  // other error of the call, e.g. of the transport
+}
+
+// NewCassette returns an empty cassette for recording
+func NewCassette() *Cassette {
+	return &Cassette{}
+}
+
+// LoadCassette reads cassette saved by Save
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cassette{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Save writes recorded interactions to path
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// Record returns middleware recording calls passing through it, see ServerConnection.Use
+func (c *Cassette) Record() Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			data, err := next.RoundTrip(ctx, method, params)
+			interaction := Interaction{Method: method}
+			interaction.Params, _ = canonicalParams(method, params)
+			var apiError *APIError
+			switch {
+			case err == nil:
+				interaction.Response = redactJSON(data)
+			case errors.As(err, &apiError):
+				interaction.Error = apiError.report()
+			default:
+				interaction.Failure = err.Error()
+			}
+			c.mu.Lock()
+			c.Interactions = append(c.Interactions, interaction)
+			c.mu.Unlock()
+			return data, err
+		})
+	}
+}
+
+// Replay returns middleware answering calls by recorded interactions instead of the server.
+// The call is matched by method and params, interactions with the same call are replayed in the recorded order.
+// Replay fails if no unused interaction matches the call.
+func (c *Cassette) Replay() Middleware {
+	return func(RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			p, err := canonicalParams(method, params)
+			if err != nil {
+				return nil, err
+			}
+			interaction, ok := c.next(method, p)
+			if !ok {
+				return nil, fmt.Errorf("cassette: no recorded interaction for %s %s", method, p)
+			}
+			switch {
+			case interaction.Error != nil:
+				return nil, newAPIError(*interaction.Error)
+			case interaction.Failure != "":
+				return nil, errors.New(interaction.Failure)
+			}
+			return interaction.Response, nil
+		})
+	}
+}
+
+// next returns the first unused interaction of method with params
+func (c *Cassette) next(method string, params json.RawMessage) (*Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.used) != len(c.Interactions) {
+		c.used = make([]bool, len(c.Interactions))
+	}
+	for i := range c.Interactions {
+		interaction := &c.Interactions[i]
+		// recorded params are normalized again, the saved file may be formatted differently
+		if !c.used[i] && interaction.Method == method && string(redactParams(method, interaction.Params)) == string(params) {
+			c.used[i] = true
+			return interaction, true
+		}
+	}
+	return nil, false
+}
+
+// canonicalParams returns params of method encoded to JSON with sorted members and scrubbed secrets
+func canonicalParams(method string, params interface{}) (json.RawMessage, error) {
+	if params == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	return redactParams(method, data), nil
+}
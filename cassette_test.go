@@ -0,0 +1,165 @@
+package control_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// cassetteFile - cassette recorded from the fake server by exchange, rewritten by go test -update
+const cassetteFile = "testdata/cassettes/users.json"
+
+var update = flag.Bool("update", false, "update the recorded cassette in testdata")
+
+// passwords must not be recorded
+const (
+	operator         = "operator"
+	operatorPassword = "0perator-s3cret"
+	userPassword     = "Us3r-s3cret"
+)
+
+// exchange calls conn the same way when recording and replaying
+func exchange(t *testing.T, conn *control.ServerConnection) {
+	t.Helper()
+	if _, err := conn.SessionGetUserName(); !errors.Is(err, control.ErrSessionExpired) {
+		t.Errorf("got %v before login, expected session expired", err)
+	}
+	if err := conn.Login(operator, "wrong-s3cret", nil); !errors.Is(err, control.ErrAccessDenied) {
+		t.Errorf("got %v for wrong password, expected access denied", err)
+	}
+	if err := conn.Login(operator, operatorPassword, nil); err != nil {
+		t.Fatal(err)
+	}
+	user := control.User{Credentials: control.CredentialsConfig{UserName: "jdoe", Password: userPassword, PasswordChanged: true}, FullName: "John Doe"}
+	errs, created, err := conn.UsersCreate(control.UserList{user}, "local")
+	if err != nil || len(errs) != 0 || len(created) != 1 || created[0].Id == "" {
+		t.Errorf("got %v, %v, %v, expected the created user", errs, created, err)
+	}
+	_, users, total, err := conn.UsersGet(control.SearchQuery{Limit: -1}, "local")
+	if err != nil || total != 1 || len(users) != 1 || users[0].FullName != "John Doe" || !users[0].Credentials.PasswordChanged {
+		t.Errorf("got %v of %d, %v, expected the created user", users, total, err)
+	}
+	if errs, err = conn.UsersRemove(control.KIdList{"missing"}, "local"); err != nil || len(errs) != 1 || errs[0].Code != control.CodeNotFound {
+		t.Errorf("got %v, %v for missing id, expected not found", errs, err)
+	}
+	name, err := conn.SessionGetUserName()
+	if err != nil || name != operator {
+		t.Errorf("got %q, %v, expected %q", name, err, operator)
+	}
+	if err = conn.Logout(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCassetteRecord(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	fake.AddUser(operator, operatorPassword)
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cassette := control.NewCassette()
+	conn.Use(cassette.Record())
+	// the token is read from the saved session before logout
+	var token string
+	conn.Use(func(next control.RoundTripper) control.RoundTripper {
+		return control.RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			if method == "Session.logout" {
+				token = sessionToken(t, conn)
+			}
+			return next.RoundTrip(ctx, method, params)
+		})
+	})
+	exchange(t, conn)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err = cassette.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == "" {
+		t.Fatal("token not found")
+	}
+	for _, secret := range []string{operatorPassword, "wrong-s3cret", userPassword, token} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("secret %q saved in cassette:\n%s", secret, data)
+		}
+	}
+	if *update {
+		if err = ioutil.WriteFile(cassetteFile, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	expected, err := ioutil.ReadFile(cassetteFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("recorded cassette differs from %s, run go test -run TestCassetteRecord -update if intended:\n%s", cassetteFile, data)
+	}
+}
+
+// sessionToken returns the token of the logged in connection
+func sessionToken(t *testing.T, conn *control.ServerConnection) string {
+	path := filepath.Join(t.TempDir(), "session.json")
+	if err := conn.SaveSession(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := struct {
+		Token string `json:"token"`
+	}{}
+	if err = json.Unmarshal(data, &session); err != nil {
+		t.Fatal(err)
+	}
+	return session.Token
+}
+
+// replayConnection returns connection answered by the recorded cassette, no server listens at its address
+func replayConnection(t *testing.T) *control.ServerConnection {
+	cassette, err := control.LoadCassette(cassetteFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := control.NewConfig("127.0.0.1:1").NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Use(cassette.Replay())
+	return conn
+}
+
+func TestCassetteReplay(t *testing.T) {
+	conn := replayConnection(t)
+	exchange(t, conn)
+	// every interaction is replayed once
+	if _, err := conn.SessionGetUserName(); err == nil {
+		t.Error("got no error of an exhausted interaction")
+	}
+}
+
+func TestCassetteReplayUnmatched(t *testing.T) {
+	conn := replayConnection(t)
+	// params differ from the recorded ones
+	if _, _, _, err := conn.UsersGet(control.SearchQuery{Limit: 10}, "local"); err == nil {
+		t.Error("got no error of a call not recorded")
+	}
+	if _, err := conn.IpServicesApply(); err == nil {
+		t.Error("got no error of a method not recorded")
+	}
+}
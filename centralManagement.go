@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type CentralManagementConfig struct {
 	Enabled bool   `json:"enabled"`
@@ -17,7 +20,12 @@ type CentralManagementStatus struct {
 // Return
 //	config - Contains Structure with Central management settings.
 func (s *ServerConnection) CentralManagementGet() (*CentralManagementConfig, error) {
-	data, err := s.CallRaw("CentralManagement.get", nil)
+	return s.CentralManagementGetContext(context.Background())
+}
+
+// CentralManagementGetContext - CentralManagementGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CentralManagementGetContext(ctx context.Context) (*CentralManagementConfig, error) {
+	data, err := s.CallRawContext(ctx, "CentralManagement.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -33,10 +41,15 @@ func (s *ServerConnection) CentralManagementGet() (*CentralManagementConfig, err
 // CentralManagementSet - Stores configuration
 //	config - Contains Structure with Central management settings.
 func (s *ServerConnection) CentralManagementSet(config CentralManagementConfig) error {
+	return s.CentralManagementSetContext(context.Background(), config)
+}
+
+// CentralManagementSetContext - CentralManagementSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CentralManagementSetContext(ctx context.Context, config CentralManagementConfig) error {
 	params := struct {
 		Config CentralManagementConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("CentralManagement.set", params)
+	_, err := s.CallRawContext(ctx, "CentralManagement.set", params)
 	return err
 }
 
@@ -44,7 +57,12 @@ func (s *ServerConnection) CentralManagementSet(config CentralManagementConfig)
 // Return
 //	status - actual state of Central management.
 func (s *ServerConnection) CentralManagementGetStatus() (*CentralManagementStatus, error) {
-	data, err := s.CallRaw("CentralManagement.getStatus", nil)
+	return s.CentralManagementGetStatusContext(context.Background())
+}
+
+// CentralManagementGetStatusContext - CentralManagementGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CentralManagementGetStatusContext(ctx context.Context) (*CentralManagementStatus, error) {
+	data, err := s.CallRawContext(ctx, "CentralManagement.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +77,11 @@ func (s *ServerConnection) CentralManagementGetStatus() (*CentralManagementStatu
 
 // CentralManagementReset - Runs reset
 func (s *ServerConnection) CentralManagementReset() error {
-	_, err := s.CallRaw("CentralManagement.reset", nil)
+	return s.CentralManagementResetContext(context.Background())
+}
+
+// CentralManagementResetContext - CentralManagementReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CentralManagementResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "CentralManagement.reset", nil)
 	return err
 }
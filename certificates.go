@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // ValidType - Certificate Time properties info
 type ValidType string
@@ -41,6 +44,11 @@ const (
 // Return
 //	id - ID of generated certificate
 func (s *ServerConnection) CertificatesGenerateEx(subject NamedValueList, name string, certificateType CertificateType, period ValidPeriod, subjectAlternativeNameList NamedMultiValueList) (*KId, error) {
+	return s.CertificatesGenerateExContext(context.Background(), subject, name, certificateType, period, subjectAlternativeNameList)
+}
+
+// CertificatesGenerateExContext - CertificatesGenerateEx with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesGenerateExContext(ctx context.Context, subject NamedValueList, name string, certificateType CertificateType, period ValidPeriod, subjectAlternativeNameList NamedMultiValueList) (*KId, error) {
 	params := struct {
 		Subject                    NamedValueList      `json:"subject"`
 		Name                       string              `json:"name"`
@@ -48,7 +56,7 @@ func (s *ServerConnection) CertificatesGenerateEx(subject NamedValueList, name s
 		Period                     ValidPeriod         `json:"period"`
 		SubjectAlternativeNameList NamedMultiValueList `json:"subjectAlternativeNameList"`
 	}{subject, name, certificateType, period, subjectAlternativeNameList}
-	data, err := s.CallRaw("Certificates.generateEx", params)
+	data, err := s.CallRawContext(ctx, "Certificates.generateEx", params)
 	if err != nil {
 		return nil, err
 	}
@@ -96,10 +104,15 @@ type CertificateList []Certificate
 // Return
 //	certificate - detected properties
 func (s *ServerConnection) CertificatesDetect(host string) (*Certificate, error) {
+	return s.CertificatesDetectContext(context.Background(), host)
+}
+
+// CertificatesDetectContext - CertificatesDetect with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesDetectContext(ctx context.Context, host string) (*Certificate, error) {
 	params := struct {
 		Host string `json:"host"`
 	}{host}
-	data, err := s.CallRaw("Certificates.detect", params)
+	data, err := s.CallRawContext(ctx, "Certificates.detect", params)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +129,12 @@ func (s *ServerConnection) CertificatesDetect(host string) (*Certificate, error)
 // Return
 //	errors - list of errors
 func (s *ServerConnection) CertificatesApply() (ErrorList, error) {
-	data, err := s.CallRaw("Certificates.apply", nil)
+	return s.CertificatesApplyContext(context.Background())
+}
+
+// CertificatesApplyContext - CertificatesApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "Certificates.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +149,12 @@ func (s *ServerConnection) CertificatesApply() (ErrorList, error) {
 
 // CertificatesReset - discard changes cached in manager
 func (s *ServerConnection) CertificatesReset() error {
-	_, err := s.CallRaw("Certificates.reset", nil)
+	return s.CertificatesResetContext(context.Background())
+}
+
+// CertificatesResetContext - CertificatesReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Certificates.reset", nil)
 	return err
 }
 
@@ -142,13 +165,18 @@ func (s *ServerConnection) CertificatesReset() error {
 // Return
 //	id - ID of generated certificate
 func (s *ServerConnection) CertificatesImportCertificateP12(fileId string, name string, certificateType CertificateType, password string) (*KId, error) {
+	return s.CertificatesImportCertificateP12Context(context.Background(), fileId, name, certificateType, password)
+}
+
+// CertificatesImportCertificateP12Context - CertificatesImportCertificateP12 with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesImportCertificateP12Context(ctx context.Context, fileId string, name string, certificateType CertificateType, password string) (*KId, error) {
 	params := struct {
 		FileId          string          `json:"fileId"`
 		Name            string          `json:"name"`
 		CertificateType CertificateType `json:"certificateType"`
 		Password        string          `json:"password"`
 	}{fileId, name, certificateType, password}
-	data, err := s.CallRaw("Certificates.importCertificateP12", params)
+	data, err := s.CallRawContext(ctx, "Certificates.importCertificateP12", params)
 	if err != nil {
 		return nil, err
 	}
@@ -168,12 +196,17 @@ func (s *ServerConnection) CertificatesImportCertificateP12(fileId string, name
 // Return
 //	fileDownload - description of the output file
 func (s *ServerConnection) CertificatesExportCertificateP12(id KId, password string, includeCa bool) (*Download, error) {
+	return s.CertificatesExportCertificateP12Context(context.Background(), id, password, includeCa)
+}
+
+// CertificatesExportCertificateP12Context - CertificatesExportCertificateP12 with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesExportCertificateP12Context(ctx context.Context, id KId, password string, includeCa bool) (*Download, error) {
 	params := struct {
 		Id        KId    `json:"id"`
 		Password  string `json:"password"`
 		IncludeCa bool   `json:"includeCa"`
 	}{id, password, includeCa}
-	data, err := s.CallRaw("Certificates.exportCertificateP12", params)
+	data, err := s.CallRawContext(ctx, "Certificates.exportCertificateP12", params)
 	if err != nil {
 		return nil, err
 	}
@@ -189,10 +222,15 @@ func (s *ServerConnection) CertificatesExportCertificateP12(id KId, password str
 // CertificatesImportCertificateUrl - Import certificate from url
 //	url - url, where will be certificate downloaded from
 func (s *ServerConnection) CertificatesImportCertificateUrl(url string) error {
+	return s.CertificatesImportCertificateUrlContext(context.Background(), url)
+}
+
+// CertificatesImportCertificateUrlContext - CertificatesImportCertificateUrl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesImportCertificateUrlContext(ctx context.Context, url string) error {
 	params := struct {
 		Url string `json:"url"`
 	}{url}
-	_, err := s.CallRaw("Certificates.importCertificateUrl", params)
+	_, err := s.CallRawContext(ctx, "Certificates.importCertificateUrl", params)
 	return err
 }
 
@@ -201,10 +239,15 @@ func (s *ServerConnection) CertificatesImportCertificateUrl(url string) error {
 // Return
 //	errors - error message list
 func (s *ServerConnection) CertificatesSetDistrusted(ids KIdList) (ErrorList, error) {
+	return s.CertificatesSetDistrustedContext(context.Background(), ids)
+}
+
+// CertificatesSetDistrustedContext - CertificatesSetDistrusted with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesSetDistrustedContext(ctx context.Context, ids KIdList) (ErrorList, error) {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	data, err := s.CallRaw("Certificates.setDistrusted", params)
+	data, err := s.CallRawContext(ctx, "Certificates.setDistrusted", params)
 	if err != nil {
 		return nil, err
 	}
@@ -216,3 +259,220 @@ func (s *ServerConnection) CertificatesSetDistrusted(ids KIdList) (ErrorList, er
 	err = json.Unmarshal(data, &errors)
 	return errors.Result.Errors, err
 }
+
+// CertificatesGet - Obtain a list of certificates
+//	query - conditions and limits
+// Return
+//	certificates - current list of certificates
+//	totalItems - count of all certificates on server (before the start/limit applied)
+func (s *ServerConnection) CertificatesGet(query SearchQuery) (CertificateList, int, error) {
+	return s.CertificatesGetContext(context.Background(), query)
+}
+
+// CertificatesGetContext - CertificatesGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesGetContext(ctx context.Context, query SearchQuery) (CertificateList, int, error) {
+	query = addMissedParametersToSearchQuery(query)
+	params := struct {
+		Query SearchQuery `json:"query"`
+	}{query}
+	data, err := s.CallRawContext(ctx, "Certificates.get", params)
+	if err != nil {
+		return nil, 0, err
+	}
+	certificates := struct {
+		Result struct {
+			Certificates CertificateList `json:"certificates"`
+			TotalItems   int             `json:"totalItems"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &certificates)
+	return certificates.Result.Certificates, certificates.Result.TotalItems, err
+}
+
+// CertificatesRemove - Remove list of certificate records
+//	ids - list of identifiers of deleted certificates
+// Return
+//	errors - error message list
+func (s *ServerConnection) CertificatesRemove(ids KIdList) (ErrorList, error) {
+	return s.CertificatesRemoveContext(context.Background(), ids)
+}
+
+// CertificatesRemoveContext - CertificatesRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesRemoveContext(ctx context.Context, ids KIdList) (ErrorList, error) {
+	params := struct {
+		Ids KIdList `json:"ids"`
+	}{ids}
+	data, err := s.CallRawContext(ctx, "Certificates.remove", params)
+	if err != nil {
+		return nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList `json:"errors"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, err
+}
+
+// CertificatesGenerate - Generate certificate or certificate request (CSR).
+//	subject - properties specified by user
+//	name - name of the new certificate
+//	period - time properties specified by user, not relevant for CertificateRequest
+// Return
+//	id - ID of generated certificate
+func (s *ServerConnection) CertificatesGenerate(subject NamedValueList, name string, certificateType CertificateType, period ValidPeriod) (*KId, error) {
+	return s.CertificatesGenerateContext(context.Background(), subject, name, certificateType, period)
+}
+
+// CertificatesGenerateContext - CertificatesGenerate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesGenerateContext(ctx context.Context, subject NamedValueList, name string, certificateType CertificateType, period ValidPeriod) (*KId, error) {
+	params := struct {
+		Subject         NamedValueList  `json:"subject"`
+		Name            string          `json:"name"`
+		CertificateType CertificateType `json:"certificateType"`
+		Period          ValidPeriod     `json:"period"`
+	}{subject, name, certificateType, period}
+	data, err := s.CallRawContext(ctx, "Certificates.generate", params)
+	if err != nil {
+		return nil, err
+	}
+	id := struct {
+		Result struct {
+			Id KId `json:"id"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &id)
+	return &id.Result.Id, err
+}
+
+// CertificatesImportCertificate - Import certificate in PEM format
+//	keyId - ID of private key or certificate request which belongs to this certificate
+//	fileId - id of uploaded file
+//	name - name of the new certificate
+// Return
+//	id - ID of generated certificate
+func (s *ServerConnection) CertificatesImportCertificate(keyId KId, fileId string, name string, certificateType CertificateType) (*KId, error) {
+	return s.CertificatesImportCertificateContext(context.Background(), keyId, fileId, name, certificateType)
+}
+
+// CertificatesImportCertificateContext - CertificatesImportCertificate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesImportCertificateContext(ctx context.Context, keyId KId, fileId string, name string, certificateType CertificateType) (*KId, error) {
+	params := struct {
+		KeyId           KId             `json:"keyId"`
+		FileId          string          `json:"fileId"`
+		Name            string          `json:"name"`
+		CertificateType CertificateType `json:"certificateType"`
+	}{keyId, fileId, name, certificateType}
+	data, err := s.CallRawContext(ctx, "Certificates.importCertificate", params)
+	if err != nil {
+		return nil, err
+	}
+	id := struct {
+		Result struct {
+			Id KId `json:"id"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &id)
+	return &id.Result.Id, err
+}
+
+// CertificatesExportCertificate - Export of certificate or certificate request in PEM format
+//	id - ID of the certificate or certificate request
+// Return
+//	fileDownload - description of the output file
+func (s *ServerConnection) CertificatesExportCertificate(id KId) (*Download, error) {
+	return s.CertificatesExportCertificateContext(context.Background(), id)
+}
+
+// CertificatesExportCertificateContext - CertificatesExportCertificate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesExportCertificateContext(ctx context.Context, id KId) (*Download, error) {
+	params := struct {
+		Id KId `json:"id"`
+	}{id}
+	data, err := s.CallRawContext(ctx, "Certificates.exportCertificate", params)
+	if err != nil {
+		return nil, err
+	}
+	fileDownload := struct {
+		Result struct {
+			FileDownload Download `json:"fileDownload"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &fileDownload)
+	return &fileDownload.Result.FileDownload, err
+}
+
+// CertificatesExportPrivateKey - Export of certificate or request private key in PEM format
+//	id - ID of the certificate or certificate request
+// Return
+//	fileDownload - description of the output file
+func (s *ServerConnection) CertificatesExportPrivateKey(id KId) (*Download, error) {
+	return s.CertificatesExportPrivateKeyContext(context.Background(), id)
+}
+
+// CertificatesExportPrivateKeyContext - CertificatesExportPrivateKey with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesExportPrivateKeyContext(ctx context.Context, id KId) (*Download, error) {
+	params := struct {
+		Id KId `json:"id"`
+	}{id}
+	data, err := s.CallRawContext(ctx, "Certificates.exportPrivateKey", params)
+	if err != nil {
+		return nil, err
+	}
+	fileDownload := struct {
+		Result struct {
+			FileDownload Download `json:"fileDownload"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &fileDownload)
+	return &fileDownload.Result.FileDownload, err
+}
+
+// CertificatesToSource - Obtain source (plain-text representation) of the certificate
+//	id - ID of the certificate or certificate request
+// Return
+//	source - certificate in plain text
+func (s *ServerConnection) CertificatesToSource(id KId) (string, error) {
+	return s.CertificatesToSourceContext(context.Background(), id)
+}
+
+// CertificatesToSourceContext - CertificatesToSource with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesToSourceContext(ctx context.Context, id KId) (string, error) {
+	params := struct {
+		Id KId `json:"id"`
+	}{id}
+	data, err := s.CallRawContext(ctx, "Certificates.toSource", params)
+	if err != nil {
+		return "", err
+	}
+	source := struct {
+		Result struct {
+			Source string `json:"source"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &source)
+	return source.Result.Source, err
+}
+
+// CertificatesGetCountryList - Get a list of countries
+// Return
+//	countries - list of countries (name and ISO 3166 code)
+func (s *ServerConnection) CertificatesGetCountryList() (NamedValueList, error) {
+	return s.CertificatesGetCountryListContext(context.Background())
+}
+
+// CertificatesGetCountryListContext - CertificatesGetCountryList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) CertificatesGetCountryListContext(ctx context.Context) (NamedValueList, error) {
+	data, err := s.CallRawContext(ctx, "Certificates.getCountryList", nil)
+	if err != nil {
+		return nil, err
+	}
+	countries := struct {
+		Result struct {
+			Countries NamedValueList `json:"countries"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &countries)
+	return countries.Result.Countries, err
+}
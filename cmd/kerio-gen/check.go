@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// maxErrors - count of type errors reported by check
+const maxErrors = 10
+
+// check type-checks the generated sources together, declarations of package control the generated code
+// depends on (ServerConnection, types not defined by the IDL files...) are replaced by stubs
+func (g *generator) check(files []*file, sources map[string][]byte) error {
+	fset := token.NewFileSet()
+	parsed := make([]*ast.File, 0, len(sources)+1)
+	for _, path := range sortedKeys(sources) {
+		f, err := parser.ParseFile(fset, filepath.Base(path), sources[path], parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("generated invalid code: %w", err)
+		}
+		parsed = append(parsed, f)
+	}
+	stubs, err := parser.ParseFile(fset, "stubs.go", g.stubs(files), 0)
+	if err != nil {
+		return fmt.Errorf("invalid stubs: %w", err)
+	}
+	parsed = append(parsed, stubs)
+	var errs []string
+	config := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			errs = append(errs, err.Error())
+		},
+	}
+	_, _ = config.Check(g.pkg, fset, parsed, nil)
+	if len(errs) > maxErrors {
+		errs = append(errs[:maxErrors], "...")
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("generated invalid code:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// stubs returns source of declarations the generated code uses but does not declare
+func (g *generator) stubs(files []*file) []byte {
+	declared := map[string]bool{}
+	var used []string
+	use := func(typ string) {
+		typ = strings.TrimLeft(typ, "[]")
+		if _, ok := builtins[typ]; !ok {
+			used = append(used, typ)
+		}
+	}
+	for _, f := range files {
+		for _, c := range f.consts {
+			use(c.typ)
+		}
+		for _, d := range f.decls {
+			declared[d.declName()] = true
+			switch d := d.(type) {
+			case *structDecl:
+				for _, fl := range d.fields {
+					use(fl.typ)
+				}
+			case *typedefDecl:
+				use(d.typ)
+			}
+		}
+		for _, it := range f.ifaces {
+			for _, m := range it.methods {
+				for _, p := range m.params {
+					use(p.typ)
+				}
+			}
+		}
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package %s\n\nimport \"context\"\n\n", g.pkg)
+	b.WriteString("type ServerConnection struct{}\n\n")
+	b.WriteString("func (s *ServerConnection) CallRawContext(ctx context.Context, method string, params interface{}) ([]byte, error) {\n\treturn nil, nil\n}\n\n")
+	b.WriteString("func addMissedParametersToSearchQuery(query SearchQuery) SearchQuery {\n\treturn query\n}\n")
+	for _, name := range append(used, "SearchQuery") {
+		if declared[name] {
+			continue
+		}
+		declared[name] = true
+		// lists are returned by value, other types by pointer, see resultType
+		if strings.HasPrefix(g.resultType(name), "*") {
+			fmt.Fprintf(&b, "\ntype %s struct{}\n", name)
+		} else {
+			fmt.Fprintf(&b, "\ntype %s []struct{}\n", name)
+		}
+	}
+	return b.Bytes()
+}
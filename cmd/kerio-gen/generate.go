@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+)
+
+// builtin types of IDL and their Go counterparts
+var builtins = map[string]string{
+	"string":             "string",
+	"boolean":            "bool",
+	"bool":               "bool",
+	"long":               "int",
+	"int":                "int",
+	"short":              "int",
+	"unsigned long":      "int",
+	"unsigned short":     "int",
+	"long long":          "int",
+	"unsigned long long": "int",
+	"double":             "float64",
+	"float":              "float64",
+	"octet":              "byte",
+}
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true,
+	"if": true, "import": true, "interface": true, "map": true, "package": true, "range": true,
+	"return": true, "select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+// names reserved in generated methods
+var reserved = map[string]bool{"s": true, "ctx": true, "params": true, "data": true, "err": true}
+
+type generator struct {
+	pkg       string
+	lists     map[string]bool   // names of types defined as sequences
+	constants map[string]string // Go names of enum values, keyed by enum and value
+	buf       bytes.Buffer
+}
+
+func newGenerator(pkg string, files []*file) *generator {
+	g := &generator{
+		pkg:       pkg,
+		lists:     map[string]bool{},
+		constants: map[string]string{},
+	}
+	// enum values with the same name in several enums are prefixed by the name of the enum
+	count := map[string]int{}
+	for _, f := range files {
+		for _, c := range f.consts {
+			count[c.name]++
+		}
+		for _, d := range f.decls {
+			count[d.declName()]++
+			switch d := d.(type) {
+			case *typedefDecl:
+				if strings.HasPrefix(d.typ, "[]") {
+					g.lists[d.name] = true
+				}
+			case *enumDecl:
+				for _, v := range d.values {
+					count[v.name]++
+				}
+			}
+		}
+	}
+	for _, f := range files {
+		for _, d := range f.decls {
+			if e, ok := d.(*enumDecl); ok {
+				for _, v := range e.values {
+					name := v.name
+					if count[name] > 1 && !strings.HasPrefix(name, e.name) {
+						name = e.name + upperFirst(name)
+					}
+					g.constants[e.name+"."+v.name] = name
+				}
+			}
+		}
+	}
+	return g
+}
+
+// generate returns Go source of the file
+func (g *generator) generate(f *file) []byte {
+	g.buf.Reset()
+	g.printf("package %s\n", g.pkg)
+	// methods use context, methods with out params decode the response
+	var methods, results bool
+	for _, it := range f.ifaces {
+		for _, m := range it.methods {
+			methods = true
+			for _, p := range m.params {
+				results = results || p.out
+			}
+		}
+	}
+	switch {
+	case results:
+		g.printf("\nimport (\n\t\"context\"\n\t\"encoding/json\"\n)\n")
+	case methods:
+		g.printf("\nimport \"context\"\n")
+	}
+	for _, c := range f.consts {
+		g.printf("\n")
+		g.printDoc(c.name, c.doc)
+		g.printf("const %s %s = %s\n", c.name, goType(c.typ), c.value)
+	}
+	for _, d := range f.decls {
+		switch d := d.(type) {
+		case *enumDecl:
+			g.enum(d)
+		case *structDecl:
+			g.structure(d)
+		case *typedefDecl:
+			g.printf("\n")
+			g.printDoc(d.name, d.doc)
+			g.printf("type %s %s\n", d.name, goType(d.typ))
+		}
+	}
+	for _, it := range f.ifaces {
+		for _, m := range it.methods {
+			g.method(it, m)
+		}
+	}
+	// the output is not passed to gofmt, it would change the layout of doc comments, it is type-checked by check
+	return append([]byte{}, g.buf.Bytes()...)
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// printDoc prints doc comment of declaration name in the form "// Name - text"
+func (g *generator) printDoc(name string, d doc) {
+	for i, line := range d.text {
+		if i == 0 {
+			g.printf("// %s - %s\n", name, line)
+		} else {
+			g.printf("// %s\n", line)
+		}
+	}
+}
+
+// printAligned prints rows of cells separated by tabs aligned in columns, each line prefixed by indent
+func (g *generator) printAligned(indent string, rows []string) {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 1, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintln(w, row)
+	}
+	_ = w.Flush()
+	for _, line := range strings.SplitAfter(b.String(), "\n") {
+		if line != "" {
+			g.printf("%s%s", indent, strings.TrimRight(line, " \n")+"\n")
+		}
+	}
+}
+
+func (g *generator) enum(e *enumDecl) {
+	g.printf("\n")
+	g.printDoc(e.name, e.doc)
+	g.printf("type %s string\n\nconst (\n", e.name)
+	rows := make([]string, 0, len(e.values))
+	for _, v := range e.values {
+		row := fmt.Sprintf("%s\t%s = %q", g.constants[e.name+"."+v.name], e.name, v.name)
+		if v.comment != "" {
+			row += "\t// " + v.comment
+		}
+		rows = append(rows, row)
+	}
+	g.printAligned("\t", rows)
+	g.printf(")\n")
+}
+
+func (g *generator) structure(s *structDecl) {
+	g.printf("\n")
+	g.printDoc(s.name, s.doc)
+	g.printf("type %s struct {\n", s.name)
+	rows := make([]string, 0, len(s.fields))
+	for _, fl := range s.fields {
+		row := fmt.Sprintf("%s\t%s\t`json:\"%s\"`", upperFirst(fl.name), goType(fl.typ), fl.name)
+		if fl.comment != "" {
+			row += "\t// " + fl.comment
+		}
+		rows = append(rows, row)
+	}
+	g.printAligned("\t", rows)
+	g.printf("}\n")
+}
+
+func (g *generator) method(it *iface, m *method) {
+	name := it.name + upperFirst(m.name)
+	var ins, outs []param
+	for _, p := range m.params {
+		if p.out {
+			outs = append(outs, p)
+		} else {
+			ins = append(ins, p)
+		}
+	}
+	// names of variables and of fields of params, which differ only in case of the first letter
+	inNames := map[string]bool{}
+	var args, names []string
+	for i := range ins {
+		name := safeName(ins[i].name, inNames)
+		ins[i].goName = name
+		inNames[lowerFirst(name)] = true
+		inNames[upperFirst(name)] = true
+		args = append(args, name+" "+goType(ins[i].typ))
+		names = append(names, name)
+	}
+	var results, zeros []string
+	for _, p := range outs {
+		results = append(results, g.resultType(p.typ))
+		zeros = append(zeros, g.zero(p.typ))
+	}
+	results = append(results, "error")
+	zeros = append(zeros, "err")
+	resultList := results[0]
+	if len(results) > 1 {
+		resultList = "(" + strings.Join(results, ", ") + ")"
+	}
+
+	// doc
+	g.printf("\n")
+	text := m.doc.text
+	if len(text) == 0 {
+		text = []string{m.name}
+	}
+	g.printDoc(name, doc{text: text})
+	for _, p := range ins {
+		if desc := m.doc.params[p.name]; desc != "" {
+			g.printf("//\t%s - %s\n", p.name, desc)
+		}
+	}
+	var returns []string
+	for _, p := range outs {
+		if desc := m.doc.params[p.name]; desc != "" {
+			returns = append(returns, fmt.Sprintf("//\t%s - %s\n", p.name, desc))
+		}
+	}
+	if len(returns) > 0 {
+		g.printf("// Return\n%s", strings.Join(returns, ""))
+	}
+
+	// method without context
+	callArgs := append([]string{"context.Background()"}, names...)
+	g.printf("func (s *ServerConnection) %s(%s) %s {\n", name, strings.Join(args, ", "), resultList)
+	g.printf("\treturn s.%sContext(%s)\n}\n\n", name, strings.Join(callArgs, ", "))
+
+	// method with context
+	g.printf("// %sContext - %s with a context controlling cancellation and deadline of the call\n", name, name)
+	g.printf("func (s *ServerConnection) %sContext(%s) %s {\n", name, strings.Join(append([]string{"ctx context.Context"}, args...), ", "), resultList)
+	paramsArg := "nil"
+	if len(ins) > 0 {
+		for _, p := range ins {
+			if goType(p.typ) == "SearchQuery" {
+				g.printf("\t%s = addMissedParametersToSearchQuery(%s)\n", p.goName, p.goName)
+			}
+		}
+		g.printf("\tparams := struct {\n")
+		rows := make([]string, 0, len(ins))
+		for _, p := range ins {
+			rows = append(rows, fmt.Sprintf("%s\t%s\t`json:\"%s\"`", upperFirst(p.goName), goType(p.typ), p.name))
+		}
+		g.printAligned("\t\t", rows)
+		g.printf("\t}{%s}\n", strings.Join(names, ", "))
+		paramsArg = "params"
+	}
+	method := fmt.Sprintf("%q", it.name+"."+m.name)
+	if len(outs) == 0 {
+		g.printf("\t_, err := s.CallRawContext(ctx, %s, %s)\n\treturn err\n}\n", method, paramsArg)
+		return
+	}
+	g.printf("\tdata, err := s.CallRawContext(ctx, %s, %s)\n", method, paramsArg)
+	g.printf("\tif err != nil {\n\t\treturn %s\n\t}\n", strings.Join(zeros, ", "))
+	result := safeName(outs[0].name, inNames)
+	g.printf("\t%s := struct {\n\t\tResult struct {\n", result)
+	rows := make([]string, 0, len(outs))
+	for _, p := range outs {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t`json:\"%s\"`", upperFirst(p.name), goType(p.typ), p.name))
+	}
+	g.printAligned("\t\t\t", rows)
+	g.printf("\t\t} `json:\"result\"`\n\t}{}\n")
+	g.printf("\terr = json.Unmarshal(data, &%s)\n", result)
+	var values []string
+	for _, p := range outs {
+		value := result + ".Result." + upperFirst(p.name)
+		if strings.HasPrefix(g.resultType(p.typ), "*") {
+			value = "&" + value
+		}
+		values = append(values, value)
+	}
+	g.printf("\treturn %s, err\n}\n", strings.Join(values, ", "))
+}
+
+// resultType returns Go type of out param, structures and other named types are returned as pointers
+func (g *generator) resultType(typ string) string {
+	t := goType(typ)
+	if _, ok := builtins[typ]; ok || strings.HasPrefix(t, "[]") || g.lists[typ] || strings.HasSuffix(typ, "List") {
+		return t
+	}
+	return "*" + t
+}
+
+// zero returns zero value of out param returned on error
+func (g *generator) zero(typ string) string {
+	switch t := g.resultType(typ); {
+	case t == "string":
+		return `""`
+	case t == "bool":
+		return "false"
+	case t == "int" || t == "float64" || t == "byte":
+		return "0"
+	default:
+		return "nil"
+	}
+}
+
+func goType(typ string) string {
+	if strings.HasPrefix(typ, "[]") {
+		return "[]" + goType(typ[2:])
+	}
+	if t, ok := builtins[typ]; ok {
+		return t
+	}
+	return typ
+}
+
+// safeName returns name usable as Go variable, not colliding with keywords, reserved names and taken names
+func safeName(name string, taken map[string]bool) string {
+	for goKeywords[name] || reserved[name] || taken[name] {
+		name += "Value"
+	}
+	return name
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// sortedKeys returns keys of m in ascending order
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// parseSample parses the IDL files in testdata
+func parseSample(t *testing.T) []*file {
+	t.Helper()
+	var files []*file
+	for _, name := range []string{"Sample", "Silent"} {
+		src, err := ioutil.ReadFile(filepath.Join("testdata", name+".idl"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parse(name, string(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+func TestParse(t *testing.T) {
+	f := parseSample(t)[0]
+	if len(f.consts) != 1 || f.consts[0].name != "MAX_ITEMS" || f.consts[0].typ != "long" || f.consts[0].value != "100" {
+		t.Errorf("got consts %+v", f.consts)
+	}
+	if len(f.decls) != 4 {
+		t.Fatalf("got %d declarations, expected 4", len(f.decls))
+	}
+	mode := f.decls[0].(*enumDecl)
+	expectedValues := []enumValue{{"Disabled", "item is ignored"}, {"Enabled", ""}, {"Custom", ""}}
+	if mode.name != "ItemMode" || !reflect.DeepEqual(mode.values, expectedValues) || !reflect.DeepEqual(mode.doc.text, []string{"Mode of the item"}) {
+		t.Errorf("got enum %+v", mode)
+	}
+	item := f.decls[2].(*structDecl)
+	expectedFields := []field{
+		{"KId", "id", ""},
+		{"string", "name", "name shown to users"},
+		{"ItemMode", "mode", ""},
+		{"boolean", "default", ""},
+		{"[]string", "tags", ""},
+		{"unsigned long long", "size", ""},
+	}
+	if !reflect.DeepEqual(item.fields, expectedFields) {
+		t.Errorf("got fields %+v", item.fields)
+	}
+	if list := f.decls[3].(*typedefDecl); list.name != "ItemList" || list.typ != "[]Item" {
+		t.Errorf("got typedef %+v", list)
+	}
+	if len(f.ifaces) != 1 || len(f.ifaces[0].methods) != 4 {
+		t.Fatalf("got interfaces %+v", f.ifaces)
+	}
+	get := f.ifaces[0].methods[0]
+	expectedParams := []param{{typ: "SearchQuery", name: "query"}, {out: true, typ: "ItemList", name: "list"}, {out: true, typ: "long", name: "totalItems"}}
+	if get.name != "get" || !reflect.DeepEqual(get.params, expectedParams) {
+		t.Errorf("got method %+v", get)
+	}
+	if desc := get.doc.params["query"]; desc != "conditions and limits" {
+		t.Errorf("got description of query %q", desc)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	files := parseSample(t)
+	g := newGenerator("control", files)
+	sources := map[string][]byte{}
+	for _, f := range files {
+		sources[lowerFirst(f.name)+".go"] = g.generate(f)
+	}
+	if err := g.check(files, sources); err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range sources {
+		golden := filepath.Join("testdata", name+".golden")
+		if *update {
+			if err := ioutil.WriteFile(golden, src, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(src, expected) {
+			t.Errorf("%s differs from %s, run go test -update if intended:\n%s", name, golden, src)
+		}
+	}
+	// params renamed in Go keep their names on the wire
+	for _, tag := range []string{"TypeValue      string `json:\"type\"`", "TypeValueValue string `json:\"typeValue\"`", "DefaultValue   string `json:\"defaultValue\"`"} {
+		if !bytes.Contains(sources["sample.go"], []byte(tag)) {
+			t.Errorf("missing field %s", tag)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	g := newGenerator("control", nil)
+	sources := map[string][]byte{
+		"broken.go": []byte("package control\n\nimport \"encoding/json\"\n\nfunc f(a int, a string) {}\n"),
+	}
+	err := g.check(nil, sources)
+	if err == nil {
+		t.Fatal("got no error of invalid code")
+	}
+	for _, expected := range []string{"imported and not used", "redeclared"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("got %v, expected error %q", err, expected)
+		}
+	}
+}
@@ -0,0 +1,541 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// IDL declarations
+
+type file struct {
+	name   string // base name without extension, e.g. "Certificates"
+	consts []*constDecl
+	decls  []decl // enums, structs and typedefs in order of declaration
+	ifaces []*iface
+}
+
+type decl interface{ declName() string }
+
+type doc struct {
+	text   []string          // description
+	params map[string]string // @param name - description
+}
+
+type constDecl struct {
+	doc   doc
+	typ   string
+	name  string
+	value string
+}
+
+type enumValue struct {
+	name    string
+	comment string
+}
+
+type enumDecl struct {
+	doc    doc
+	name   string
+	values []enumValue
+}
+
+type field struct {
+	typ     string
+	name    string
+	comment string
+}
+
+type structDecl struct {
+	doc    doc
+	name   string
+	fields []field
+}
+
+type typedefDecl struct {
+	doc  doc
+	typ  string
+	name string
+}
+
+type param struct {
+	out    bool
+	typ    string
+	name   string // name in IDL, sent to the server
+	goName string // name of Go variable, set by the generator
+}
+
+type method struct {
+	doc    doc
+	name   string
+	params []param
+}
+
+type iface struct {
+	name    string
+	methods []*method
+}
+
+func (d *enumDecl) declName() string    { return d.name }
+func (d *structDecl) declName() string  { return d.name }
+func (d *typedefDecl) declName() string { return d.name }
+
+// Lexer
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokPunct
+	tokDoc     // /** ... */
+	tokComment // // ... or /* ... */
+)
+
+type lexToken struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func lex(src string) ([]lexToken, error) {
+	var tokens []lexToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#':
+			// preprocessor directives are ignored
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			tokens = append(tokens, lexToken{tokComment, strings.TrimSpace(src[i+2 : i+end]), line})
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			text := src[i+2 : i+2+end]
+			kind := tokComment
+			if strings.HasPrefix(text, "*") {
+				kind = tokDoc
+				text = text[1:]
+			}
+			tokens = append(tokens, lexToken{kind, text, line})
+			line += strings.Count(text, "\n")
+			i += end + 4
+		case c == '"':
+			end := strings.IndexByte(src[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			tokens = append(tokens, lexToken{tokString, src[i : i+end+2], line})
+			i += end + 2
+		case isIdentRune(rune(c)) && !unicode.IsDigit(rune(c)):
+			j := i
+			for j < len(src) && (isIdentRune(rune(src[j])) || strings.HasPrefix(src[j:], "::")) {
+				if src[j] == ':' {
+					j++
+				}
+				j++
+			}
+			tokens = append(tokens, lexToken{tokIdent, src[i:j], line})
+			i = j
+		case unicode.IsDigit(rune(c)) || (c == '-' && i+1 < len(src) && unicode.IsDigit(rune(src[i+1]))):
+			j := i + 1
+			for j < len(src) && (unicode.IsDigit(rune(src[j])) || src[j] == '.' || src[j] == 'x' || unicode.Is(unicode.ASCII_Hex_Digit, rune(src[j]))) {
+				j++
+			}
+			tokens = append(tokens, lexToken{tokNumber, src[i:j], line})
+			i = j
+		case strings.ContainsRune("{}()<>;,=:[]", rune(c)):
+			tokens = append(tokens, lexToken{tokPunct, string(c), line})
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return append(tokens, lexToken{tokEOF, "", line}), nil
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Parser
+
+type idlParser struct {
+	tokens []lexToken
+	pos    int
+	doc    *doc // the last doc comment not yet attached to a declaration
+}
+
+func parse(name, src string) (*file, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	p := &idlParser{tokens: tokens}
+	f := &file{name: name}
+	if err = p.parseBlock(f, true); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return f, nil
+}
+
+// next returns the next token, doc comments are remembered and other comments skipped
+func (p *idlParser) next() lexToken {
+	for {
+		t := p.tokens[p.pos]
+		if t.kind != tokEOF {
+			p.pos++
+		}
+		switch t.kind {
+		case tokDoc:
+			d := parseDoc(t.text)
+			p.doc = &d
+		case tokComment:
+		default:
+			return t
+		}
+	}
+}
+
+func (p *idlParser) peek() lexToken {
+	pos, d := p.pos, p.doc
+	t := p.next()
+	p.pos, p.doc = pos, d
+	return t
+}
+
+// trailingComment returns a line comment following on the line of the previous token
+func (p *idlParser) trailingComment() string {
+	if p.pos == 0 || p.pos >= len(p.tokens) {
+		return ""
+	}
+	t, prev := p.tokens[p.pos], p.tokens[p.pos-1]
+	if t.kind == tokComment && t.line == prev.line {
+		p.pos++
+		return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(t.text), "/"))
+	}
+	return ""
+}
+
+func (p *idlParser) takeDoc() doc {
+	if p.doc == nil {
+		return doc{}
+	}
+	d := *p.doc
+	p.doc = nil
+	return d
+}
+
+func (p *idlParser) expect(text string) error {
+	if t := p.next(); t.text != text {
+		return fmt.Errorf("line %d: expected %q, got %q", t.line, text, t.text)
+	}
+	return nil
+}
+
+func (p *idlParser) ident() (string, error) {
+	t := p.next()
+	if t.kind != tokIdent {
+		return "", fmt.Errorf("line %d: expected identifier, got %q", t.line, t.text)
+	}
+	return t.text, nil
+}
+
+// parseBlock parses declarations up to "}" or the end of the file
+func (p *idlParser) parseBlock(f *file, top bool) error {
+	for {
+		t := p.peek()
+		switch {
+		case t.kind == tokEOF:
+			if !top {
+				return fmt.Errorf("line %d: unexpected end of file", t.line)
+			}
+			return nil
+		case t.text == "}":
+			if top {
+				return fmt.Errorf("line %d: unexpected }", t.line)
+			}
+			p.next()
+			if p.peek().text == ";" {
+				p.next()
+			}
+			return nil
+		case t.text == ";":
+			p.next()
+			continue
+		}
+		var err error
+		switch t.text {
+		case "module":
+			p.next()
+			if _, err = p.ident(); err == nil {
+				if err = p.expect("{"); err == nil {
+					err = p.parseBlock(f, false)
+				}
+			}
+		case "enum":
+			err = p.parseEnum(f)
+		case "struct":
+			err = p.parseStruct(f)
+		case "typedef":
+			err = p.parseTypedef(f)
+		case "interface":
+			err = p.parseInterface(f)
+		case "const":
+			err = p.parseConst(f)
+		default:
+			err = p.skipDecl()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// skipDecl skips unsupported declaration up to ";" outside of braces
+func (p *idlParser) skipDecl() error {
+	depth := 0
+	for {
+		t := p.next()
+		switch {
+		case t.kind == tokEOF:
+			return fmt.Errorf("line %d: unexpected end of file", t.line)
+		case t.text == "{":
+			depth++
+		case t.text == "}":
+			depth--
+		case t.text == ";" && depth == 0:
+			p.takeDoc()
+			return nil
+		}
+	}
+}
+
+func (p *idlParser) parseEnum(f *file) error {
+	p.next()
+	d := &enumDecl{doc: p.takeDoc()}
+	var err error
+	if d.name, err = p.ident(); err != nil {
+		return err
+	}
+	if err = p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		t := p.next()
+		if t.text == "}" {
+			break
+		}
+		if t.kind != tokIdent {
+			return fmt.Errorf("line %d: expected enum value, got %q", t.line, t.text)
+		}
+		value := enumValue{name: lastName(t.text)}
+		if p.peek().text == "=" {
+			p.next()
+			p.next()
+		}
+		if p.peek().text == "," {
+			p.next()
+		}
+		value.comment = p.trailingComment()
+		p.takeDoc()
+		d.values = append(d.values, value)
+	}
+	f.decls = append(f.decls, d)
+	return p.expect(";")
+}
+
+func (p *idlParser) parseStruct(f *file) error {
+	p.next()
+	d := &structDecl{doc: p.takeDoc()}
+	var err error
+	if d.name, err = p.ident(); err != nil {
+		return err
+	}
+	if err = p.expect("{"); err != nil {
+		return err
+	}
+	for p.peek().text != "}" {
+		fl := field{}
+		if fl.typ, err = p.parseType(); err != nil {
+			return err
+		}
+		if fl.name, err = p.ident(); err != nil {
+			return err
+		}
+		if err = p.expect(";"); err != nil {
+			return err
+		}
+		fl.comment = p.trailingComment()
+		p.takeDoc()
+		d.fields = append(d.fields, fl)
+	}
+	p.next()
+	f.decls = append(f.decls, d)
+	return p.expect(";")
+}
+
+func (p *idlParser) parseTypedef(f *file) error {
+	p.next()
+	d := &typedefDecl{doc: p.takeDoc()}
+	var err error
+	if d.typ, err = p.parseType(); err != nil {
+		return err
+	}
+	if d.name, err = p.ident(); err != nil {
+		return err
+	}
+	f.decls = append(f.decls, d)
+	return p.expect(";")
+}
+
+func (p *idlParser) parseConst(f *file) error {
+	p.next()
+	d := &constDecl{doc: p.takeDoc()}
+	var err error
+	if d.typ, err = p.parseType(); err != nil {
+		return err
+	}
+	if d.name, err = p.ident(); err != nil {
+		return err
+	}
+	if err = p.expect("="); err != nil {
+		return err
+	}
+	d.value = p.next().text
+	f.consts = append(f.consts, d)
+	return p.expect(";")
+}
+
+func (p *idlParser) parseInterface(f *file) error {
+	p.next()
+	p.takeDoc()
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	it := &iface{name: name}
+	if err = p.expect("{"); err != nil {
+		return err
+	}
+	for p.peek().text != "}" {
+		m := &method{}
+		// return type, usually void
+		if _, err = p.parseType(); err != nil {
+			return err
+		}
+		m.doc = p.takeDoc()
+		if m.name, err = p.ident(); err != nil {
+			return err
+		}
+		if err = p.expect("("); err != nil {
+			return err
+		}
+		for p.peek().text != ")" {
+			prm := param{}
+			switch p.peek().text {
+			case "out":
+				prm.out = true
+				p.next()
+			case "in":
+				p.next()
+			}
+			if prm.typ, err = p.parseType(); err != nil {
+				return err
+			}
+			if prm.name, err = p.ident(); err != nil {
+				return err
+			}
+			m.params = append(m.params, prm)
+			if p.peek().text == "," {
+				p.next()
+			}
+		}
+		p.next()
+		if err = p.expect(";"); err != nil {
+			return err
+		}
+		it.methods = append(it.methods, m)
+	}
+	p.next()
+	f.ifaces = append(f.ifaces, it)
+	return p.expect(";")
+}
+
+// parseType parses type, sequences are returned as "[]T", multi-word types (unsigned long) are joined
+func (p *idlParser) parseType() (string, error) {
+	name, err := p.ident()
+	if err != nil {
+		return "", err
+	}
+	switch name {
+	case "sequence":
+		if err = p.expect("<"); err != nil {
+			return "", err
+		}
+		elem, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, p.expect(">")
+	case "unsigned", "long", "short":
+		for t := p.peek(); t.text == "long" || t.text == "short" || t.text == "int"; t = p.peek() {
+			p.next()
+			name += " " + t.text
+		}
+	}
+	return lastName(name), nil
+}
+
+// lastName strips namespaces, e.g. kerio::web::KId -> KId
+func lastName(name string) string {
+	if i := strings.LastIndex(name, "::"); i >= 0 {
+		return name[i+2:]
+	}
+	return name
+}
+
+// parseDoc parses javadoc-like comment
+func parseDoc(text string) doc {
+	d := doc{params: map[string]string{}}
+	lastParam := ""
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*"))
+		switch {
+		case line == "":
+			lastParam = ""
+		case strings.HasPrefix(line, "@param"):
+			fields := strings.Fields(strings.TrimPrefix(line, "@param"))
+			if len(fields) == 0 {
+				continue
+			}
+			lastParam = fields[0]
+			desc := strings.TrimSpace(strings.Join(fields[1:], " "))
+			d.params[lastParam] = strings.TrimSpace(strings.TrimPrefix(desc, "-"))
+		case strings.HasPrefix(line, "@"):
+			lastParam = ""
+		case lastParam != "":
+			d.params[lastParam] += " " + line
+		default:
+			d.text = append(d.text, line)
+		}
+	}
+	return d
+}
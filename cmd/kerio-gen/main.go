@@ -0,0 +1,69 @@
+// Command kerio-gen generates typed structures, enum constants and ServerConnection methods
+// of package control from Kerio Control API interface definitions (.idl files).
+//
+// Usage:
+//
+//	kerio-gen [-pkg control] [-out dir] file.idl...
+//
+// For every IDL file a Go file named after it is written to the output directory,
+// e.g. IpAddressGroups.idl -> ipAddressGroups.go. Types shared by several files should be
+// generated in one run, so that enum values with the same name are prefixed consistently.
+// Existing files are overwritten, hand-written helpers belong to separate files.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	pkg := flag.String("pkg", "control", "name of the generated package")
+	out := flag.String("out", ".", "output directory")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] file.idl...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*pkg, *out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "kerio-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(pkg, out string, paths []string) error {
+	files := make([]*file, 0, len(paths))
+	for _, path := range paths {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		f, err := parse(name, string(src))
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+	}
+	g := newGenerator(pkg, files)
+	sources := map[string][]byte{}
+	for _, f := range files {
+		sources[filepath.Join(out, lowerFirst(f.name)+".go")] = g.generate(f)
+	}
+	if err := g.check(files, sources); err != nil {
+		return err
+	}
+	for _, path := range sortedKeys(sources) {
+		if err := ioutil.WriteFile(path, sources[path], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
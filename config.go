@@ -3,6 +3,7 @@ package control
 import (
 	"net/url"
 	"strings"
+	"sync/atomic"
 )
 
 const (
@@ -44,7 +45,7 @@ func NewApplication(name, vendor, version string) *ApiApplication {
 	}
 }
 
+// getID returns a new request id, safe for concurrent use
 func (c *Config) getID() int {
-	c.id++
-	return c.id
+	return int(atomic.AddInt64(&c.id, 1))
 }
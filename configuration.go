@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ExportOptions struct {
 	Certificates bool `json:"certificates"`
@@ -36,10 +39,15 @@ type CurrentInterfaceList []CurrentInterface
 // Return
 //	fileDownload - description of the output file
 func (s *ServerConnection) ConfigurationExportConfig(options ExportOptions) (*Download, error) {
+	return s.ConfigurationExportConfigContext(context.Background(), options)
+}
+
+// ConfigurationExportConfigContext - ConfigurationExportConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationExportConfigContext(ctx context.Context, options ExportOptions) (*Download, error) {
 	params := struct {
 		Options ExportOptions `json:"options"`
 	}{options}
-	data, err := s.CallRaw("Configuration.exportConfig", params)
+	data, err := s.CallRawContext(ctx, "Configuration.exportConfig", params)
 	if err != nil {
 		return nil, err
 	}
@@ -61,10 +69,15 @@ func (s *ServerConnection) ConfigurationExportConfig(options ExportOptions) (*Do
 //	importedInterfaces - a list of interfaces loaded from the imported configuration file.
 //	currentInterfaces - a list of interfaces available in currently loaded configuration.
 func (s *ServerConnection) ConfigurationGetImportInfo(fileId string) (ErrorList, bool, bool, ImportedInterfaceList, CurrentInterfaceList, error) {
+	return s.ConfigurationGetImportInfoContext(context.Background(), fileId)
+}
+
+// ConfigurationGetImportInfoContext - ConfigurationGetImportInfo with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationGetImportInfoContext(ctx context.Context, fileId string) (ErrorList, bool, bool, ImportedInterfaceList, CurrentInterfaceList, error) {
 	params := struct {
 		FileId string `json:"fileId"`
 	}{fileId}
-	data, err := s.CallRaw("Configuration.getImportInfo", params)
+	data, err := s.CallRawContext(ctx, "Configuration.getImportInfo", params)
 	if err != nil {
 		return nil, false, false, nil, nil, err
 	}
@@ -88,12 +101,17 @@ func (s *ServerConnection) ConfigurationGetImportInfo(fileId string) (ErrorList,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) ConfigurationApply(interfaces ImportedInterfaceList, id string, fullImport bool) (ErrorList, error) {
+	return s.ConfigurationApplyContext(context.Background(), interfaces, id, fullImport)
+}
+
+// ConfigurationApplyContext - ConfigurationApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationApplyContext(ctx context.Context, interfaces ImportedInterfaceList, id string, fullImport bool) (ErrorList, error) {
 	params := struct {
 		Interfaces ImportedInterfaceList `json:"interfaces"`
 		Id         string                `json:"id"`
 		FullImport bool                  `json:"fullImport"`
 	}{interfaces, id, fullImport}
-	data, err := s.CallRaw("Configuration.apply", params)
+	data, err := s.CallRawContext(ctx, "Configuration.apply", params)
 	if err != nil {
 		return nil, err
 	}
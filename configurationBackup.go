@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type Target string
 
@@ -37,7 +40,12 @@ type ConfigurationBackupStatus struct {
 // Return
 //	config - Contains Structure with Configuration backup settings.
 func (s *ServerConnection) ConfigurationBackupGet() (*ConfigurationBackupConfig, error) {
-	data, err := s.CallRaw("ConfigurationBackup.get", nil)
+	return s.ConfigurationBackupGetContext(context.Background())
+}
+
+// ConfigurationBackupGetContext - ConfigurationBackupGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationBackupGetContext(ctx context.Context) (*ConfigurationBackupConfig, error) {
+	data, err := s.CallRawContext(ctx, "ConfigurationBackup.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -53,16 +61,26 @@ func (s *ServerConnection) ConfigurationBackupGet() (*ConfigurationBackupConfig,
 // ConfigurationBackupSet - Stores configuration
 //	config - Contains Structure with Configuration backup settings.
 func (s *ServerConnection) ConfigurationBackupSet(config ConfigurationBackupConfig) error {
+	return s.ConfigurationBackupSetContext(context.Background(), config)
+}
+
+// ConfigurationBackupSetContext - ConfigurationBackupSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationBackupSetContext(ctx context.Context, config ConfigurationBackupConfig) error {
 	params := struct {
 		Config ConfigurationBackupConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ConfigurationBackup.set", params)
+	_, err := s.CallRawContext(ctx, "ConfigurationBackup.set", params)
 	return err
 }
 
 // ConfigurationBackupBackupNow - Runs backup
 func (s *ServerConnection) ConfigurationBackupBackupNow() error {
-	_, err := s.CallRaw("ConfigurationBackup.backupNow", nil)
+	return s.ConfigurationBackupBackupNowContext(context.Background())
+}
+
+// ConfigurationBackupBackupNowContext - ConfigurationBackupBackupNow with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationBackupBackupNowContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "ConfigurationBackup.backupNow", nil)
 	return err
 }
 
@@ -70,7 +88,12 @@ func (s *ServerConnection) ConfigurationBackupBackupNow() error {
 // Return
 //	status - a phase of update process.
 func (s *ServerConnection) ConfigurationBackupGetStatus() (*ConfigurationBackupStatus, error) {
-	data, err := s.CallRaw("ConfigurationBackup.getStatus", nil)
+	return s.ConfigurationBackupGetStatusContext(context.Background())
+}
+
+// ConfigurationBackupGetStatusContext - ConfigurationBackupGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConfigurationBackupGetStatusContext(ctx context.Context) (*ConfigurationBackupStatus, error) {
+	data, err := s.CallRawContext(ctx, "ConfigurationBackup.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
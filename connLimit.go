@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ConnLimitSettings struct {
 	SrcLimit         OptionalLong        `json:"srcLimit"`
@@ -16,7 +19,12 @@ type ConnLimitSettings struct {
 // Return
 //  config - Connection Limit configuration
 func (s *ServerConnection) ConnLimitGet() (*ConnLimitSettings, error) {
-	data, err := s.CallRaw("ConnLimit.get", nil)
+	return s.ConnLimitGetContext(context.Background())
+}
+
+// ConnLimitGetContext - ConnLimitGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConnLimitGetContext(ctx context.Context) (*ConnLimitSettings, error) {
+	data, err := s.CallRawContext(ctx, "ConnLimit.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -32,9 +40,14 @@ func (s *ServerConnection) ConnLimitGet() (*ConnLimitSettings, error) {
 // ConnLimitSet - Stores Connection Limit configuration
 //  config - Connection Limit configuration
 func (s *ServerConnection) ConnLimitSet(config ConnLimitSettings) error {
+	return s.ConnLimitSetContext(context.Background(), config)
+}
+
+// ConnLimitSetContext - ConnLimitSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConnLimitSetContext(ctx context.Context, config ConnLimitSettings) error {
 	params := struct {
 		Config ConnLimitSettings `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ConnLimit.set", params)
+	_, err := s.CallRawContext(ctx, "ConnLimit.set", params)
 	return err
 }
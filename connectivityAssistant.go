@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type WanInterfaceConfig struct {
 	Id                  KId                `json:"id"` // not used on Box
@@ -38,11 +41,16 @@ type ConnectivityAssistantConfig struct {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) ConnectivityAssistantSet(config ConnectivityAssistantConfig, revertTimeout int) (ErrorList, error) {
+	return s.ConnectivityAssistantSetContext(context.Background(), config, revertTimeout)
+}
+
+// ConnectivityAssistantSetContext - ConnectivityAssistantSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ConnectivityAssistantSetContext(ctx context.Context, config ConnectivityAssistantConfig, revertTimeout int) (ErrorList, error) {
 	params := struct {
 		Config        ConnectivityAssistantConfig `json:"config"`
 		RevertTimeout int                         `json:"revertTimeout"`
 	}{config, revertTimeout}
-	data, err := s.CallRaw("ConnectivityAssistant.set", params)
+	data, err := s.CallRawContext(ctx, "ConnectivityAssistant.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // HttpsConfig - HTTPS configuration
 type HttpsConfig struct {
@@ -168,7 +171,12 @@ type ContentRuleList []ContentRule
 // Return
 //	list - list of rule and it's details
 func (s *ServerConnection) ContentFilterGet() (ContentRuleList, error) {
-	data, err := s.CallRaw("ContentFilter.get", nil)
+	return s.ContentFilterGetContext(context.Background())
+}
+
+// ContentFilterGetContext - ContentFilterGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetContext(ctx context.Context) (ContentRuleList, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,10 +194,15 @@ func (s *ServerConnection) ContentFilterGet() (ContentRuleList, error) {
 // Return
 //	errors - list of errors occured during method call
 func (s *ServerConnection) ContentFilterSet(rules ContentRuleList) (ErrorList, error) {
+	return s.ContentFilterSetContext(context.Background(), rules)
+}
+
+// ContentFilterSetContext - ContentFilterSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterSetContext(ctx context.Context, rules ContentRuleList) (ErrorList, error) {
 	params := struct {
 		Rules ContentRuleList `json:"rules"`
 	}{rules}
-	data, err := s.CallRaw("ContentFilter.set", params)
+	data, err := s.CallRawContext(ctx, "ContentFilter.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +219,12 @@ func (s *ServerConnection) ContentFilterSet(rules ContentRuleList) (ErrorList, e
 // Return
 //  list - list of overlapped rules
 func (s *ServerConnection) ContentFilterGetCollisions() (CollisionList, error) {
-	data, err := s.CallRaw("ContentFilter.getCollisions", nil)
+	return s.ContentFilterGetCollisionsContext(context.Background())
+}
+
+// ContentFilterGetCollisionsContext - ContentFilterGetCollisions with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetCollisionsContext(ctx context.Context) (CollisionList, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getCollisions", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +241,12 @@ func (s *ServerConnection) ContentFilterGetCollisions() (CollisionList, error) {
 // Return
 //	categories - list of webfilter/application categories and applications
 func (s *ServerConnection) ContentFilterGetContentApplicationList() (ContentApplicationList, error) {
-	data, err := s.CallRaw("ContentFilter.getContentApplicationList", nil)
+	return s.ContentFilterGetContentApplicationListContext(context.Background())
+}
+
+// ContentFilterGetContentApplicationListContext - ContentFilterGetContentApplicationList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetContentApplicationListContext(ctx context.Context) (ContentApplicationList, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getContentApplicationList", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +263,12 @@ func (s *ServerConnection) ContentFilterGetContentApplicationList() (ContentAppl
 // Return
 //	groups - list of filename groups
 func (s *ServerConnection) ContentFilterGetFilenameGroups() (FilenameGroupList, error) {
-	data, err := s.CallRaw("ContentFilter.getFilenameGroups", nil)
+	return s.ContentFilterGetFilenameGroupsContext(context.Background())
+}
+
+// ContentFilterGetFilenameGroupsContext - ContentFilterGetFilenameGroups with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetFilenameGroupsContext(ctx context.Context) (FilenameGroupList, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getFilenameGroups", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +285,12 @@ func (s *ServerConnection) ContentFilterGetFilenameGroups() (FilenameGroupList,
 // Return
 //	config - configuration values
 func (s *ServerConnection) ContentFilterGetUrlFilterConfig() (*UrlFilterConfig, error) {
-	data, err := s.CallRaw("ContentFilter.getUrlFilterConfig", nil)
+	return s.ContentFilterGetUrlFilterConfigContext(context.Background())
+}
+
+// ContentFilterGetUrlFilterConfigContext - ContentFilterGetUrlFilterConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetUrlFilterConfigContext(ctx context.Context) (*UrlFilterConfig, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getUrlFilterConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -273,10 +306,15 @@ func (s *ServerConnection) ContentFilterGetUrlFilterConfig() (*UrlFilterConfig,
 // ContentFilterSetUrlFilterConfig - Stores configuration of WebFilter
 //	config - configuration values
 func (s *ServerConnection) ContentFilterSetUrlFilterConfig(config UrlFilterConfig) error {
+	return s.ContentFilterSetUrlFilterConfigContext(context.Background(), config)
+}
+
+// ContentFilterSetUrlFilterConfigContext - ContentFilterSetUrlFilterConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterSetUrlFilterConfigContext(ctx context.Context, config UrlFilterConfig) error {
 	params := struct {
 		Config UrlFilterConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ContentFilter.setUrlFilterConfig", params)
+	_, err := s.CallRawContext(ctx, "ContentFilter.setUrlFilterConfig", params)
 	return err
 }
 
@@ -284,11 +322,16 @@ func (s *ServerConnection) ContentFilterSetUrlFilterConfig(config UrlFilterConfi
 //	url - URL, that is miscategorized
 //	categoryIds - up to 3 suggested categories. Can be empty, if new category is not known
 func (s *ServerConnection) ContentFilterReportMiscategorizedUrl(url string, categoryIds IntegerList) error {
+	return s.ContentFilterReportMiscategorizedUrlContext(context.Background(), url, categoryIds)
+}
+
+// ContentFilterReportMiscategorizedUrlContext - ContentFilterReportMiscategorizedUrl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterReportMiscategorizedUrlContext(ctx context.Context, url string, categoryIds IntegerList) error {
 	params := struct {
 		Url         string      `json:"url"`
 		CategoryIds IntegerList `json:"categoryIds"`
 	}{url, categoryIds}
-	_, err := s.CallRaw("ContentFilter.reportMiscategorizedUrl", params)
+	_, err := s.CallRawContext(ctx, "ContentFilter.reportMiscategorizedUrl", params)
 	return err
 }
 
@@ -297,10 +340,15 @@ func (s *ServerConnection) ContentFilterReportMiscategorizedUrl(url string, cate
 // Return
 //	categoryIds - list of categories, to which given URL belongs
 func (s *ServerConnection) ContentFilterGetUrlCategories(url string) (IntegerList, error) {
+	return s.ContentFilterGetUrlCategoriesContext(context.Background(), url)
+}
+
+// ContentFilterGetUrlCategoriesContext - ContentFilterGetUrlCategories with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetUrlCategoriesContext(ctx context.Context, url string) (IntegerList, error) {
 	params := struct {
 		Url string `json:"url"`
 	}{url}
-	data, err := s.CallRaw("ContentFilter.getUrlCategories", params)
+	data, err := s.CallRawContext(ctx, "ContentFilter.getUrlCategories", params)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +365,12 @@ func (s *ServerConnection) ContentFilterGetUrlCategories(url string) (IntegerLis
 // Return
 //	config - configuration values
 func (s *ServerConnection) ContentFilterGetHttpsConfig() (*HttpsConfig, error) {
-	data, err := s.CallRaw("ContentFilter.getHttpsConfig", nil)
+	return s.ContentFilterGetHttpsConfigContext(context.Background())
+}
+
+// ContentFilterGetHttpsConfigContext - ContentFilterGetHttpsConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetHttpsConfigContext(ctx context.Context) (*HttpsConfig, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getHttpsConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -333,10 +386,15 @@ func (s *ServerConnection) ContentFilterGetHttpsConfig() (*HttpsConfig, error) {
 // ContentFilterSetHttpsConfig - Stores configuration of HTTPS Filtering
 //	config - configuration values
 func (s *ServerConnection) ContentFilterSetHttpsConfig(config HttpsConfig) error {
+	return s.ContentFilterSetHttpsConfigContext(context.Background(), config)
+}
+
+// ContentFilterSetHttpsConfigContext - ContentFilterSetHttpsConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterSetHttpsConfigContext(ctx context.Context, config HttpsConfig) error {
 	params := struct {
 		Config HttpsConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ContentFilter.setHttpsConfig", params)
+	_, err := s.CallRawContext(ctx, "ContentFilter.setHttpsConfig", params)
 	return err
 }
 
@@ -344,7 +402,12 @@ func (s *ServerConnection) ContentFilterSetHttpsConfig(config HttpsConfig) error
 // Return
 //	config - configuration values
 func (s *ServerConnection) ContentFilterGetSafeSearchConfig() (*SafeSearchConfig, error) {
-	data, err := s.CallRaw("ContentFilter.getSafeSearchConfig", nil)
+	return s.ContentFilterGetSafeSearchConfigContext(context.Background())
+}
+
+// ContentFilterGetSafeSearchConfigContext - ContentFilterGetSafeSearchConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterGetSafeSearchConfigContext(ctx context.Context) (*SafeSearchConfig, error) {
+	data, err := s.CallRawContext(ctx, "ContentFilter.getSafeSearchConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -360,15 +423,25 @@ func (s *ServerConnection) ContentFilterGetSafeSearchConfig() (*SafeSearchConfig
 // ContentFilterSetSafeSearchConfig - Stores configuration of SafeSearch
 //	config - configuration values
 func (s *ServerConnection) ContentFilterSetSafeSearchConfig(config SafeSearchConfig) error {
+	return s.ContentFilterSetSafeSearchConfigContext(context.Background(), config)
+}
+
+// ContentFilterSetSafeSearchConfigContext - ContentFilterSetSafeSearchConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterSetSafeSearchConfigContext(ctx context.Context, config SafeSearchConfig) error {
 	params := struct {
 		Config SafeSearchConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ContentFilter.setSafeSearchConfig", params)
+	_, err := s.CallRawContext(ctx, "ContentFilter.setSafeSearchConfig", params)
 	return err
 }
 
 // ContentFilterClearHttpsCertCache - Clears cache of generated certificates for HTTPS inspection.
 func (s *ServerConnection) ContentFilterClearHttpsCertCache() error {
-	_, err := s.CallRaw("ContentFilter.clearHttpsCertCache", nil)
+	return s.ContentFilterClearHttpsCertCacheContext(context.Background())
+}
+
+// ContentFilterClearHttpsCertCacheContext - ContentFilterClearHttpsCertCache with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ContentFilterClearHttpsCertCacheContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "ContentFilter.clearHttpsCertCache", nil)
 	return err
 }
@@ -0,0 +1,506 @@
+package controltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/igiant/control"
+)
+
+type item map[string]interface{}
+
+// ListManager - fake of a manager of list of items, e.g. Users or IpServices.
+// Managers with staged changes (IpServices, UrlGroups, Dhcp) show items created, updated or removed
+// by get immediately, but they are written to the configuration by apply only; reset discards them.
+// Other managers write the changes at once. Conditions and ordering of get are ignored.
+type ListManager struct {
+	mu        sync.Mutex
+	prefix    string // name of the manager, prefix of ids
+	itemsKey  string // name of the param with items in create
+	idsKey    string // name of the param with ids in set and remove
+	withApply bool   // changes are written by apply
+	committed []item
+	staged    []item
+	lastID    int
+}
+
+func newListManager(s *Server, name, itemsKey, idsKey string, withApply bool) *ListManager {
+	m := &ListManager{
+		prefix:    name,
+		itemsKey:  itemsKey,
+		idsKey:    idsKey,
+		withApply: withApply,
+	}
+	s.handlers[name+".get"] = m.get
+	s.handlers[name+".create"] = m.create
+	s.handlers[name+".set"] = m.set
+	s.handlers[name+".remove"] = m.remove
+	if withApply {
+		s.handlers[name+".apply"] = m.apply
+		s.handlers[name+".reset"] = m.reset
+	}
+	if name == "UrlGroups" {
+		s.handlers[name+".getGroupList"] = m.getGroupList
+	}
+	return m
+}
+
+// Add adds items to the configuration, as if they were created and applied.
+// items is a slice of structures of the manager, e.g. control.UserList; empty ids are assigned.
+func (m *ListManager) Add(items interface{}) error {
+	var list []item
+	if err := convert(items, &list); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, it := range list {
+		if id, _ := it["id"].(string); id == "" {
+			it["id"] = m.newID()
+		}
+		it["status"] = string(control.StoreStatusClean)
+	}
+	m.committed = append(m.committed, list...)
+	m.staged = append(m.staged, copyItems(list)...)
+	return nil
+}
+
+// Applied decodes items written to the configuration into v, a pointer to a slice of structures
+// of the manager, e.g. *control.UserList
+func (m *ListManager) Applied(v interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return convert(m.committed, v)
+}
+
+func (m *ListManager) newID() string {
+	m.lastID++
+	return fmt.Sprintf("%s-%d", m.prefix, m.lastID)
+}
+
+func (m *ListManager) index(id string) int {
+	for i, it := range m.staged {
+		if it["id"] == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *ListManager) get(params json.RawMessage) (interface{}, error) {
+	query := struct {
+		Query control.SearchQuery `json:"query"`
+	}{}
+	if err := unmarshalParams(params, &query); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	list := copyItems(m.staged)
+	start, limit := query.Query.Start, query.Query.Limit
+	if start > len(list) {
+		start = len(list)
+	}
+	if limit < 0 || start+limit > len(list) {
+		limit = len(list) - start
+	}
+	return map[string]interface{}{
+		"list":       list[start : start+limit],
+		"totalItems": len(m.staged),
+	}, nil
+}
+
+func (m *ListManager) create(params json.RawMessage) (interface{}, error) {
+	var items []item
+	if err := unmarshalKey(params, m.itemsKey, &items); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := control.CreateResultList{}
+	for i, it := range items {
+		id := m.newID()
+		it["id"] = id
+		it["status"] = string(control.StoreStatusNew)
+		m.staged = append(m.staged, it)
+		result = append(result, control.CreateResult{InputIndex: i, Id: control.KId(id)})
+	}
+	m.commitUnstaged()
+	return map[string]interface{}{
+		"errors": control.ErrorList{},
+		"result": result,
+	}, nil
+}
+
+func (m *ListManager) set(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		Ids     []string `json:"-"`
+		Details item     `json:"details"`
+	}{}
+	if err := unmarshalKey(params, m.idsKey, &p.Ids); err != nil {
+		return nil, err
+	}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	errors := control.ErrorList{}
+	for i, id := range p.Ids {
+		n := m.index(id)
+		if n < 0 {
+			errors = append(errors, notFound(i, id))
+			continue
+		}
+		it := m.staged[n]
+		for k, v := range p.Details {
+			if k != "id" && k != "status" {
+				it[k] = v
+			}
+		}
+		if it["status"] != string(control.StoreStatusNew) {
+			it["status"] = string(control.StoreStatusModified)
+		}
+	}
+	m.commitUnstaged()
+	return map[string]interface{}{"errors": errors}, nil
+}
+
+func (m *ListManager) remove(params json.RawMessage) (interface{}, error) {
+	var ids []string
+	if err := unmarshalKey(params, m.idsKey, &ids); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	errors := control.ErrorList{}
+	for i, id := range ids {
+		n := m.index(id)
+		if n < 0 {
+			errors = append(errors, notFound(i, id))
+			continue
+		}
+		m.staged = append(m.staged[:n], m.staged[n+1:]...)
+	}
+	m.commitUnstaged()
+	return map[string]interface{}{"errors": errors}, nil
+}
+
+func (m *ListManager) apply(json.RawMessage) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.commit()
+	return map[string]interface{}{"errors": control.ErrorList{}}, nil
+}
+
+func (m *ListManager) commit() {
+	for _, it := range m.staged {
+		it["status"] = string(control.StoreStatusClean)
+	}
+	m.committed = copyItems(m.staged)
+}
+
+// commitUnstaged writes the changes of managers without apply
+func (m *ListManager) commitUnstaged() {
+	if !m.withApply {
+		m.commit()
+	}
+}
+
+func (m *ListManager) reset(json.RawMessage) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.staged = copyItems(m.committed)
+	return nil, nil
+}
+
+func (m *ListManager) getGroupList(json.RawMessage) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	groups := control.UrlGroupList{}
+	seen := map[interface{}]bool{}
+	for _, it := range m.staged {
+		if seen[it["groupId"]] {
+			continue
+		}
+		seen[it["groupId"]] = true
+		id, _ := it["groupId"].(string)
+		name, _ := it["groupName"].(string)
+		groups = append(groups, control.UrlGroup{Id: control.KId(id), Name: name})
+	}
+	return map[string]interface{}{"groups": groups}, nil
+}
+
+// TrafficPolicy - fake of TrafficPolicy manager; the rules are stored by set at once
+type TrafficPolicy struct {
+	mu          sync.Mutex
+	rules       control.TrafficRuleList
+	defaultRule control.TrafficRule
+	lastID      int
+}
+
+func newTrafficPolicy(s *Server) *TrafficPolicy {
+	t := &TrafficPolicy{rules: control.TrafficRuleList{}}
+	s.handlers["TrafficPolicy.get"] = t.get
+	s.handlers["TrafficPolicy.set"] = t.set
+	s.handlers["TrafficPolicy.getDefaultRule"] = t.getDefaultRule
+	return t
+}
+
+// Rules returns current rules and the default rule
+func (t *TrafficPolicy) Rules() (control.TrafficRuleList, control.TrafficRule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append(control.TrafficRuleList{}, t.rules...), t.defaultRule
+}
+
+func (t *TrafficPolicy) get(json.RawMessage) (interface{}, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return map[string]interface{}{
+		"list":       t.rules,
+		"totalItems": len(t.rules),
+	}, nil
+}
+
+func (t *TrafficPolicy) set(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		Rules       control.TrafficRuleList `json:"rules"`
+		DefaultRule control.TrafficRule     `json:"defaultRule"`
+	}{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range p.Rules {
+		if p.Rules[i].Id == "" {
+			t.lastID++
+			p.Rules[i].Id = control.KId(fmt.Sprintf("TrafficPolicy-%d", t.lastID))
+		}
+	}
+	if p.Rules == nil {
+		p.Rules = control.TrafficRuleList{}
+	}
+	t.rules = p.Rules
+	t.defaultRule = p.DefaultRule
+	return map[string]interface{}{"errors": control.ErrorList{}}, nil
+}
+
+func (t *TrafficPolicy) getDefaultRule(json.RawMessage) (interface{}, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return map[string]interface{}{"rule": t.defaultRule}, nil
+}
+
+// Logs - fake of Logs manager
+type Logs struct {
+	mu   sync.Mutex
+	logs map[control.LogType]control.LogRowList
+}
+
+func newLogs(s *Server) *Logs {
+	l := &Logs{logs: map[control.LogType]control.LogRowList{}}
+	s.handlers["Logs.get"] = l.get
+	s.handlers["Logs.clear"] = l.clear
+	return l
+}
+
+// Append appends lines to the log logName
+func (l *Logs) Append(logName control.LogType, lines ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, line := range lines {
+		l.logs[logName] = append(l.logs[logName], control.LogRow{Content: line})
+	}
+}
+
+func (l *Logs) get(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		LogName    control.LogType `json:"logName"`
+		FromLine   int             `json:"fromLine"`
+		CountLines int             `json:"countLines"`
+	}{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rows := l.logs[p.LogName]
+	from, count := p.FromLine, p.CountLines
+	if count < 0 || count > len(rows) {
+		count = len(rows)
+	}
+	if from < 0 {
+		// Unlimited - the last countLines lines
+		from = len(rows) - count
+	}
+	if from > len(rows) {
+		from = len(rows)
+	}
+	if from+count > len(rows) {
+		count = len(rows) - from
+	}
+	return map[string]interface{}{
+		"viewport":   append(control.LogRowList{}, rows[from:from+count]...),
+		"totalItems": len(rows),
+	}, nil
+}
+
+func (l *Logs) clear(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		LogName control.LogType `json:"logName"`
+	}{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.logs, p.LogName)
+	return nil, nil
+}
+
+// Notifications - fake of Notifications manager including waiting for a change in get
+type Notifications struct {
+	mu            sync.Mutex
+	notifications control.NotificationList
+	changed       chan struct{} // closed on change of notifications
+	closed        chan struct{} // closed when the server stops
+}
+
+func newNotifications(s *Server) *Notifications {
+	n := &Notifications{
+		notifications: control.NotificationList{},
+		changed:       make(chan struct{}),
+		closed:        make(chan struct{}),
+	}
+	s.handlers["Notifications.get"] = n.get
+	s.handlers["Notifications.clear"] = n.clear
+	return n
+}
+
+// Set replaces current notifications, waiting calls of get return them
+func (n *Notifications) Set(notifications control.NotificationList) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notifications = append(control.NotificationList{}, notifications...)
+	n.notifyLocked()
+}
+
+// Add adds notification to current notifications
+func (n *Notifications) Add(notification control.Notification) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notifications = append(n.notifications, notification)
+	n.notifyLocked()
+}
+
+func (n *Notifications) notifyLocked() {
+	close(n.changed)
+	n.changed = make(chan struct{})
+}
+
+func (n *Notifications) close() {
+	close(n.closed)
+}
+
+func (n *Notifications) get(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		LastNotifications control.NotificationList `json:"lastNotifications"`
+		Timeout           int                      `json:"timeout"`
+	}{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	current, changed := n.notifications, n.changed
+	n.mu.Unlock()
+	if sameNotifications(current, p.LastNotifications) && p.Timeout > 0 {
+		timer := time.NewTimer(time.Duration(p.Timeout) * time.Second)
+		defer timer.Stop()
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-n.closed:
+		}
+		n.mu.Lock()
+		current = n.notifications
+		n.mu.Unlock()
+	}
+	return map[string]interface{}{"notifications": current}, nil
+}
+
+func (n *Notifications) clear(params json.RawMessage) (interface{}, error) {
+	p := struct {
+		Notification control.Notification `json:"notification"`
+	}{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	list := control.NotificationList{}
+	for _, notification := range n.notifications {
+		if notification != p.Notification {
+			list = append(list, notification)
+		}
+	}
+	n.notifications = list
+	n.notifyLocked()
+	return nil, nil
+}
+
+func sameNotifications(a, b control.NotificationList) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func unmarshalParams(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return invalidParams(err)
+	}
+	return nil
+}
+
+// unmarshalKey decodes the param key into v
+func unmarshalKey(params json.RawMessage, key string, v interface{}) error {
+	p := map[string]json.RawMessage{}
+	if err := unmarshalParams(params, &p); err != nil {
+		return err
+	}
+	return unmarshalParams(p[key], v)
+}
+
+func notFound(inputIndex int, id string) control.Error {
+	return control.Error{
+		InputIndex: inputIndex,
+		Code:       control.CodeNotFound,
+		Message:    "Item %1 not found.",
+		MessageParameters: control.LocalizableMessageParameters{
+			PositionalParameters: control.StringList{id},
+		},
+	}
+}
+
+// convert converts src to dst by JSON round trip
+func convert(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+func copyItems(items []item) []item {
+	list := make([]item, 0, len(items))
+	_ = convert(items, &list)
+	return list
+}
@@ -0,0 +1,288 @@
+// Package controltest provides an in-memory fake of Kerio Control API server for tests
+// of code depending on package control.
+//
+// The fake speaks the JSON-RPC dialect of /admin/api/jsonrpc including login, tokens and session cookies
+// and keeps state of the main managers (Users, UserGroups, IpServices, UrlGroups, Dhcp, TrafficPolicy, Logs
+// and Notifications). Changes of managers with apply are staged until apply, reset discards them.
+// Other methods may be faked by Server.Handle.
+//
+// Connections are created from Server.Config, which trusts the certificate of the fake. Configurations created
+// by control.NewConfig(fake.Addr()) have to trust it by AddRootCAs(fake.Certificate()) or by SetHTTPClient(fake.Client()).
+package controltest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/igiant/control"
+)
+
+// Default credentials accepted by the server
+const (
+	UserName = "admin"
+	Password = "admin"
+)
+
+const (
+	apiPath    = "/admin/api/jsonrpc"
+	cookieName = "SESSION_CONTROL_WEBADMIN"
+)
+
+// Handler - fake of a method; params are the raw params of the request,
+// result is encoded as "result" member of the response, error of type *control.APIError is reported
+// with its code, other errors as control.CodeInternalError
+type Handler func(params json.RawMessage) (result interface{}, err error)
+
+// Server - fake Kerio Control API server
+type Server struct {
+	mu       sync.Mutex
+	srv      *httptest.Server
+	users    map[string]string   // user name -> password
+	sessions map[string]*session // token -> session
+	handlers map[string]Handler
+	// managers with state
+	Users         *ListManager
+	UserGroups    *ListManager
+	IpServices    *ListManager
+	UrlGroups     *ListManager
+	Dhcp          *ListManager
+	TrafficPolicy *TrafficPolicy
+	Logs          *Logs
+	Notifications *Notifications
+}
+
+type session struct {
+	userName string
+	cookie   string
+}
+
+type request struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	ID      int             `json:"id"`
+	Token   string          `json:"token"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JsonRpc string               `json:"jsonrpc"`
+	ID      int                  `json:"id"`
+	Result  interface{}          `json:"result,omitempty"`
+	Error   *control.ErrorReport `json:"error,omitempty"`
+}
+
+// NewServer starts a new fake server with credentials UserName and Password, it should be stopped by Close
+func NewServer() *Server {
+	s := &Server{
+		users:    map[string]string{UserName: Password},
+		sessions: map[string]*session{},
+		handlers: map[string]Handler{},
+	}
+	s.Users = newListManager(s, "Users", "users", "userIds", false)
+	s.UserGroups = newListManager(s, "UserGroups", "groups", "groupIds", false)
+	s.IpServices = newListManager(s, "IpServices", "services", "serviceIds", true)
+	s.UrlGroups = newListManager(s, "UrlGroups", "groups", "groupIds", true)
+	s.Dhcp = newListManager(s, "Dhcp", "scopes", "scopeIds", true)
+	s.TrafficPolicy = newTrafficPolicy(s)
+	s.Logs = newLogs(s)
+	s.Notifications = newNotifications(s)
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close stops the server
+func (s *Server) Close() {
+	s.Notifications.close()
+	s.srv.Close()
+}
+
+// Addr returns address of the server in the form host:port, suitable for control.NewConfig
+func (s *Server) Addr() string {
+	return strings.TrimPrefix(s.srv.URL, "https://")
+}
+
+// Certificate returns the PEM encoded certificate of the server, suitable for control.Config.AddRootCAs
+func (s *Server) Certificate() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.srv.Certificate().Raw})
+}
+
+// Client returns HTTP client trusting the certificate of the server, suitable for control.Config.SetHTTPClient
+func (s *Server) Client() *http.Client {
+	return s.srv.Client()
+}
+
+// Config returns configuration for connecting to the server, trusting its certificate
+func (s *Server) Config() *control.Config {
+	config := control.NewConfig(s.Addr())
+	if err := config.AddRootCAs(s.Certificate()); err != nil {
+		panic("controltest: " + err.Error())
+	}
+	return config
+}
+
+// AddUser adds credentials accepted by Session.login
+func (s *Server) AddUser(userName, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[userName] = password
+}
+
+// ExpireSessions invalidates all sessions, following calls fail with control.CodeSessionExpired
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = map[string]*session{}
+}
+
+// Handle sets handler of method (e.g. "ProductInfo.get"), replacing the built-in one if any
+func (s *Server) Handle(method string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = handler
+}
+
+func (s *Server) handler(method string) Handler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.handlers[method]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != apiPath {
+		http.NotFound(w, r)
+		return
+	}
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		writeJSON(w, errorResponse(0, &control.APIError{Code: control.CodeParseError, Message: "Parse error"}))
+		return
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(raw)), "[") {
+		var requests []request
+		if err := json.Unmarshal(raw, &requests); err != nil {
+			writeJSON(w, errorResponse(0, &control.APIError{Code: control.CodeInvalidRequest, Message: "Invalid request"}))
+			return
+		}
+		responses := make([]response, len(requests))
+		for i := range requests {
+			responses[i] = s.call(w, r, &requests[i])
+		}
+		writeJSON(w, responses)
+		return
+	}
+	req := request{}
+	if err := json.Unmarshal(raw, &req); err != nil {
+		writeJSON(w, errorResponse(0, &control.APIError{Code: control.CodeInvalidRequest, Message: "Invalid request"}))
+		return
+	}
+	writeJSON(w, s.call(w, r, &req))
+}
+
+func (s *Server) call(w http.ResponseWriter, r *http.Request, req *request) response {
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "Session.login":
+		result, err = s.login(w, req.Params)
+	default:
+		var token string
+		var session *session
+		if token, session, err = s.session(r, req); err != nil {
+			break
+		}
+		switch req.Method {
+		case "Session.logout":
+			s.mu.Lock()
+			delete(s.sessions, token)
+			s.mu.Unlock()
+		case "Session.getUserName":
+			result = map[string]string{"name": session.userName}
+		default:
+			handler := s.handler(req.Method)
+			if handler == nil {
+				err = &control.APIError{Code: control.CodeMethodNotFound, Message: "Method not found"}
+				break
+			}
+			result, err = handler(req.Params)
+		}
+	}
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+	if result == nil {
+		result = struct{}{}
+	}
+	return response{JsonRpc: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *Server) login(w http.ResponseWriter, params json.RawMessage) (interface{}, error) {
+	credentials := struct {
+		UserName string `json:"userName"`
+		Password string `json:"password"`
+	}{}
+	if err := json.Unmarshal(params, &credentials); err != nil {
+		return nil, invalidParams(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	password, ok := s.users[credentials.UserName]
+	if !ok || password != credentials.Password {
+		return nil, &control.APIError{Code: control.CodeAccessDenied, Message: "Bad user name or password"}
+	}
+	token := randomString()
+	cookie := randomString()
+	s.sessions[token] = &session{userName: credentials.UserName, cookie: cookie}
+	http.SetCookie(w, &http.Cookie{Name: cookieName, Value: cookie, Path: "/", Secure: true, HttpOnly: true})
+	return map[string]string{"token": token}, nil
+}
+
+// session returns the session the request belongs to, both token and cookie must match
+func (s *Server) session(r *http.Request, req *request) (string, *session, error) {
+	token := r.Header.Get("X-Token")
+	if token == "" {
+		token = req.Token
+	}
+	cookie, _ := r.Cookie(cookieName)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[token]
+	if !ok || cookie == nil || cookie.Value != session.cookie {
+		return "", nil, &control.APIError{Code: control.CodeSessionExpired, Message: "Session expired."}
+	}
+	return token, session, nil
+}
+
+func errorResponse(id int, err error) response {
+	apiError, ok := err.(*control.APIError)
+	if !ok {
+		apiError = &control.APIError{Code: control.CodeInternalError, Message: err.Error()}
+	}
+	report := &control.ErrorReport{Code: apiError.Code, Message: apiError.Message}
+	if apiError.Template != "" {
+		report.Message = apiError.Template
+	}
+	report.Data.MessageParameters.PositionalParameters = apiError.PositionalParameters
+	report.Data.MessageParameters.Plurality = apiError.Plurality
+	return response{JsonRpc: "2.0", ID: id, Error: report}
+}
+
+func invalidParams(err error) error {
+	return &control.APIError{Code: control.CodeInvalidParams, Message: "Invalid params: " + err.Error()}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,233 @@
+package controltest_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+func login(t *testing.T, fake *controltest.Server) *control.ServerConnection {
+	t.Helper()
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestConfig(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	configs := map[string]func() *control.Config{
+		"Config": fake.Config,
+		"AddRootCAs": func() *control.Config {
+			config := control.NewConfig(fake.Addr())
+			if err := config.AddRootCAs(fake.Certificate()); err != nil {
+				t.Fatal(err)
+			}
+			return config
+		},
+		"SetHTTPClient": func() *control.Config {
+			config := control.NewConfig(fake.Addr())
+			config.SetHTTPClient(fake.Client())
+			return config
+		},
+	}
+	for name, newConfig := range configs {
+		conn, err := newConfig().NewConnection()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = conn.SessionGetUserName(); !errors.Is(err, control.ErrSessionExpired) {
+			t.Errorf("%s: got %v before login, expected session expired", name, err)
+		}
+		if err = conn.Login(controltest.UserName, "wrong", nil); !errors.Is(err, control.ErrAccessDenied) {
+			t.Errorf("%s: got %v for wrong password, expected access denied", name, err)
+		}
+		if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		userName, err := conn.SessionGetUserName()
+		if err != nil || userName != controltest.UserName {
+			t.Errorf("%s: got %q, %v, expected %q", name, userName, err, controltest.UserName)
+		}
+		if err = conn.Logout(); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, err = conn.SessionGetUserName(); !errors.Is(err, control.ErrSessionExpired) {
+			t.Errorf("%s: got %v after logout, expected session expired", name, err)
+		}
+	}
+	// the certificate of the fake is not trusted without being passed
+	conn, err := control.NewConfig(fake.Addr()).NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unknown x509.UnknownAuthorityError
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); !errors.As(err, &unknown) {
+		t.Errorf("got %v, expected unknown authority", err)
+	}
+}
+
+func TestStagedChanges(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn := login(t, fake)
+	applied := func() control.IpServiceList {
+		var list control.IpServiceList
+		if err := fake.IpServices.Applied(&list); err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+
+	_, created, err := conn.IpServicesCreate(control.IpServiceList{{Name: "http", Protocol: 6}})
+	if err != nil || len(created) != 1 {
+		t.Fatalf("got %v, %v", created, err)
+	}
+	id := created[0].Id
+	list, total, err := conn.IpServicesGet(control.SearchQuery{})
+	if err != nil || total != 1 || list[0].Status != control.StoreStatusNew {
+		t.Fatalf("got %v, %d, %v, expected the new service", list, total, err)
+	}
+	if n := len(applied()); n != 0 {
+		t.Errorf("got %d applied services before apply, expected 0", n)
+	}
+	if _, err = conn.IpServicesApply(); err != nil {
+		t.Fatal(err)
+	}
+	if list := applied(); len(list) != 1 || list[0].Name != "http" || list[0].Status != control.StoreStatusClean {
+		t.Errorf("got applied %v, expected the service", list)
+	}
+
+	// set and remove are discarded by reset
+	if _, err = conn.IpServicesSet(control.StringList{string(id)}, control.IpService{Name: "https", Protocol: 6}); err != nil {
+		t.Fatal(err)
+	}
+	list, _, _ = conn.IpServicesGet(control.SearchQuery{})
+	if list[0].Name != "https" || list[0].Status != control.StoreStatusModified {
+		t.Errorf("got %v, expected the modified service", list)
+	}
+	if err = conn.IpServicesReset(); err != nil {
+		t.Fatal(err)
+	}
+	list, _, _ = conn.IpServicesGet(control.SearchQuery{})
+	if len(list) != 1 || list[0].Name != "http" {
+		t.Errorf("got %v after reset, expected the applied service", list)
+	}
+	if _, err = conn.IpServicesRemove(control.StringList{string(id)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, _ = conn.IpServicesGet(control.SearchQuery{}); total != 0 {
+		t.Errorf("got %d services after remove, expected 0", total)
+	}
+	if err = conn.IpServicesReset(); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, _ = conn.IpServicesGet(control.SearchQuery{}); total != 1 {
+		t.Errorf("got %d services after reset, expected 1", total)
+	}
+	errs, err := conn.IpServicesRemove(control.StringList{"missing"})
+	if err != nil || len(errs) != 1 || errs[0].Code != control.CodeNotFound {
+		t.Errorf("got %v, %v for missing id, expected not found", errs, err)
+	}
+}
+
+func TestUnstagedChanges(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn := login(t, fake)
+	if _, _, err := conn.UsersCreate(control.UserList{{Credentials: control.CredentialsConfig{UserName: "jdoe"}}}, "local"); err != nil {
+		t.Fatal(err)
+	}
+	var users control.UserList
+	if err := fake.Users.Applied(&users); err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Credentials.UserName != "jdoe" {
+		t.Errorf("got %v, expected the user written at once", users)
+	}
+}
+
+func TestPaging(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn := login(t, fake)
+	var users control.UserList
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		users = append(users, control.User{Credentials: control.CredentialsConfig{UserName: name}})
+	}
+	if err := fake.Users.Add(users); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		start, limit int
+		expected     []string
+	}{
+		{0, -1, []string{"a", "b", "c", "d", "e"}},
+		{1, 2, []string{"b", "c"}},
+		{3, 10, []string{"d", "e"}},
+		{7, 2, nil},
+	}
+	for _, test := range tests {
+		_, list, total, err := conn.UsersGet(control.SearchQuery{Start: test.start, Limit: test.limit}, "local")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, user := range list {
+			names = append(names, user.Credentials.UserName)
+		}
+		if total != 5 || len(names) != len(test.expected) || (len(names) > 0 && names[0] != test.expected[0]) {
+			t.Errorf("start %d, limit %d: got %v of %d, expected %v of 5", test.start, test.limit, names, total, test.expected)
+		}
+	}
+
+	fake.Logs.Append(control.LogType("debug"), "1", "2", "3", "4")
+	rows, total, err := conn.LogsGet(control.LogType("debug"), 1, 2)
+	if err != nil || total != 4 || len(rows) != 2 || rows[0].Content != "2" {
+		t.Errorf("got %v of %d, %v, expected lines 2 and 3 of 4", rows, total, err)
+	}
+	// negative fromLine returns the last lines
+	rows, _, _ = conn.LogsGet(control.LogType("debug"), -1, 1)
+	if len(rows) != 1 || rows[0].Content != "4" {
+		t.Errorf("got %v, expected the last line", rows)
+	}
+}
+
+func TestNotificationsLongPoll(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn := login(t, fake)
+	notification := control.Notification{Type: control.NotificationUpdate}
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		fake.Notifications.Add(notification)
+	}()
+	start := time.Now()
+	list, err := conn.NotificationsGet(nil, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0] != notification {
+		t.Errorf("got %v, expected the added notification", list)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > 4*time.Second {
+		t.Errorf("get returned after %s, expected to wait for the change", elapsed)
+	}
+	// without a change get returns after the timeout
+	start = time.Now()
+	if list, err = conn.NotificationsGet(list, 1); err != nil || len(list) != 1 {
+		t.Errorf("got %v, %v, expected the unchanged notifications", list, err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("get returned after %s, expected the timeout", elapsed)
+	}
+}
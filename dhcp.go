@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type DhcpExclusion struct {
 	Description string    `json:"description"`
@@ -123,11 +126,16 @@ type DhcpMode struct {
 //	list - list of scopes and it's details
 //	totalItems - count of all scopes on server (before the start/limit applied)
 func (s *ServerConnection) DhcpGet(query SearchQuery) (DhcpScopeList, int, error) {
+	return s.DhcpGetContext(context.Background(), query)
+}
+
+// DhcpGetContext - DhcpGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetContext(ctx context.Context, query SearchQuery) (DhcpScopeList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("Dhcp.get", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -147,10 +155,15 @@ func (s *ServerConnection) DhcpGet(query SearchQuery) (DhcpScopeList, int, error
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) DhcpCreate(scopes DhcpScopeList) (ErrorList, CreateResultList, error) {
+	return s.DhcpCreateContext(context.Background(), scopes)
+}
+
+// DhcpCreateContext - DhcpCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpCreateContext(ctx context.Context, scopes DhcpScopeList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Scopes DhcpScopeList `json:"scopes"`
 	}{scopes}
-	data, err := s.CallRaw("Dhcp.create", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,11 +183,16 @@ func (s *ServerConnection) DhcpCreate(scopes DhcpScopeList) (ErrorList, CreateRe
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DhcpSet(scopeIds StringList, details DhcpScope) (ErrorList, error) {
+	return s.DhcpSetContext(context.Background(), scopeIds, details)
+}
+
+// DhcpSetContext - DhcpSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpSetContext(ctx context.Context, scopeIds StringList, details DhcpScope) (ErrorList, error) {
 	params := struct {
 		ScopeIds StringList `json:"scopeIds"`
 		Details  DhcpScope  `json:"details"`
 	}{scopeIds, details}
-	data, err := s.CallRaw("Dhcp.set", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -192,10 +210,15 @@ func (s *ServerConnection) DhcpSet(scopeIds StringList, details DhcpScope) (Erro
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DhcpRemove(scopeIds StringList) (ErrorList, error) {
+	return s.DhcpRemoveContext(context.Background(), scopeIds)
+}
+
+// DhcpRemoveContext - DhcpRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpRemoveContext(ctx context.Context, scopeIds StringList) (ErrorList, error) {
 	params := struct {
 		ScopeIds StringList `json:"scopeIds"`
 	}{scopeIds}
-	data, err := s.CallRaw("Dhcp.remove", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -213,10 +236,15 @@ func (s *ServerConnection) DhcpRemove(scopeIds StringList) (ErrorList, error) {
 // Return
 //	details - configuration for given ifaceId - can be passed to create method
 func (s *ServerConnection) DhcpGetInterfaceTemplate(ifaceId KId) (*DhcpScope, error) {
+	return s.DhcpGetInterfaceTemplateContext(context.Background(), ifaceId)
+}
+
+// DhcpGetInterfaceTemplateContext - DhcpGetInterfaceTemplate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetInterfaceTemplateContext(ctx context.Context, ifaceId KId) (*DhcpScope, error) {
 	params := struct {
 		IfaceId KId `json:"ifaceId"`
 	}{ifaceId}
-	data, err := s.CallRaw("Dhcp.getInterfaceTemplate", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.getInterfaceTemplate", params)
 	if err != nil {
 		return nil, err
 	}
@@ -236,12 +264,17 @@ func (s *ServerConnection) DhcpGetInterfaceTemplate(ifaceId KId) (*DhcpScope, er
 //	list - list of leases/reservations and it's details
 //	totalItems - count of all leases/reservations on server (before the start/limit applied)
 func (s *ServerConnection) DhcpGetLeases(query SearchQuery, scopeIds KIdList) (DhcpLeaseList, int, error) {
+	return s.DhcpGetLeasesContext(context.Background(), query, scopeIds)
+}
+
+// DhcpGetLeasesContext - DhcpGetLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetLeasesContext(ctx context.Context, query SearchQuery, scopeIds KIdList) (DhcpLeaseList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query    SearchQuery `json:"query"`
 		ScopeIds KIdList     `json:"scopeIds"`
 	}{query, scopeIds}
-	data, err := s.CallRaw("Dhcp.getLeases", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.getLeases", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -261,10 +294,15 @@ func (s *ServerConnection) DhcpGetLeases(query SearchQuery, scopeIds KIdList) (D
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) DhcpCreateLeases(leases DhcpLeaseList) (ErrorList, CreateResultList, error) {
+	return s.DhcpCreateLeasesContext(context.Background(), leases)
+}
+
+// DhcpCreateLeasesContext - DhcpCreateLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpCreateLeasesContext(ctx context.Context, leases DhcpLeaseList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Leases DhcpLeaseList `json:"leases"`
 	}{leases}
-	data, err := s.CallRaw("Dhcp.createLeases", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.createLeases", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,11 +322,16 @@ func (s *ServerConnection) DhcpCreateLeases(leases DhcpLeaseList) (ErrorList, Cr
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DhcpSetLeases(leaseIds StringList, details DhcpLease) (ErrorList, error) {
+	return s.DhcpSetLeasesContext(context.Background(), leaseIds, details)
+}
+
+// DhcpSetLeasesContext - DhcpSetLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpSetLeasesContext(ctx context.Context, leaseIds StringList, details DhcpLease) (ErrorList, error) {
 	params := struct {
 		LeaseIds StringList `json:"leaseIds"`
 		Details  DhcpLease  `json:"details"`
 	}{leaseIds, details}
-	data, err := s.CallRaw("Dhcp.setLeases", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.setLeases", params)
 	if err != nil {
 		return nil, err
 	}
@@ -306,10 +349,15 @@ func (s *ServerConnection) DhcpSetLeases(leaseIds StringList, details DhcpLease)
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DhcpRemoveLeases(leaseIds StringList) (ErrorList, error) {
+	return s.DhcpRemoveLeasesContext(context.Background(), leaseIds)
+}
+
+// DhcpRemoveLeasesContext - DhcpRemoveLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpRemoveLeasesContext(ctx context.Context, leaseIds StringList) (ErrorList, error) {
 	params := struct {
 		LeaseIds StringList `json:"leaseIds"`
 	}{leaseIds}
-	data, err := s.CallRaw("Dhcp.removeLeases", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.removeLeases", params)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +374,12 @@ func (s *ServerConnection) DhcpRemoveLeases(leaseIds StringList) (ErrorList, err
 // Return
 //	mode - result
 func (s *ServerConnection) DhcpGetMode() (*DhcpMode, error) {
-	data, err := s.CallRaw("Dhcp.getMode", nil)
+	return s.DhcpGetModeContext(context.Background())
+}
+
+// DhcpGetModeContext - DhcpGetMode with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetModeContext(ctx context.Context) (*DhcpMode, error) {
+	data, err := s.CallRawContext(ctx, "Dhcp.getMode", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -342,10 +395,15 @@ func (s *ServerConnection) DhcpGetMode() (*DhcpMode, error) {
 // DhcpSetMode - stores Dhcp mode (not part of persistent manager, changes mode immediately)
 //	mode - new value
 func (s *ServerConnection) DhcpSetMode(mode DhcpMode) error {
+	return s.DhcpSetModeContext(context.Background(), mode)
+}
+
+// DhcpSetModeContext - DhcpSetMode with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpSetModeContext(ctx context.Context, mode DhcpMode) error {
 	params := struct {
 		Mode DhcpMode `json:"mode"`
 	}{mode}
-	_, err := s.CallRaw("Dhcp.setMode", params)
+	_, err := s.CallRawContext(ctx, "Dhcp.setMode", params)
 	return err
 }
 
@@ -353,7 +411,12 @@ func (s *ServerConnection) DhcpSetMode(mode DhcpMode) error {
 // Return
 //	config - configuration values
 func (s *ServerConnection) DhcpGetConfig() (*DhcpConfig, error) {
-	data, err := s.CallRaw("Dhcp.getConfig", nil)
+	return s.DhcpGetConfigContext(context.Background())
+}
+
+// DhcpGetConfigContext - DhcpGetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetConfigContext(ctx context.Context) (*DhcpConfig, error) {
+	data, err := s.CallRawContext(ctx, "Dhcp.getConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -369,10 +432,15 @@ func (s *ServerConnection) DhcpGetConfig() (*DhcpConfig, error) {
 // DhcpSetConfig - stores Dhcp configuration (not part of persistent manager, changes settings immediately)
 //	config - configuration values
 func (s *ServerConnection) DhcpSetConfig(config DhcpConfig) error {
+	return s.DhcpSetConfigContext(context.Background(), config)
+}
+
+// DhcpSetConfigContext - DhcpSetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpSetConfigContext(ctx context.Context, config DhcpConfig) error {
 	params := struct {
 		Config DhcpConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("Dhcp.setConfig", params)
+	_, err := s.CallRawContext(ctx, "Dhcp.setConfig", params)
 	return err
 }
 
@@ -380,7 +448,12 @@ func (s *ServerConnection) DhcpSetConfig(config DhcpConfig) error {
 // Return
 //	options - list of all options
 func (s *ServerConnection) DhcpGetOptionList() (DhcpOptionList, error) {
-	data, err := s.CallRaw("Dhcp.getOptionList", nil)
+	return s.DhcpGetOptionListContext(context.Background())
+}
+
+// DhcpGetOptionListContext - DhcpGetOptionList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetOptionListContext(ctx context.Context) (DhcpOptionList, error) {
+	data, err := s.CallRawContext(ctx, "Dhcp.getOptionList", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -398,10 +471,15 @@ func (s *ServerConnection) DhcpGetOptionList() (DhcpOptionList, error) {
 // Return
 //	count - count of declined leases
 func (s *ServerConnection) DhcpGetDeclinedLeases(scopeIds KIdList) (int, error) {
+	return s.DhcpGetDeclinedLeasesContext(context.Background(), scopeIds)
+}
+
+// DhcpGetDeclinedLeasesContext - DhcpGetDeclinedLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpGetDeclinedLeasesContext(ctx context.Context, scopeIds KIdList) (int, error) {
 	params := struct {
 		ScopeIds KIdList `json:"scopeIds"`
 	}{scopeIds}
-	data, err := s.CallRaw("Dhcp.getDeclinedLeases", params)
+	data, err := s.CallRawContext(ctx, "Dhcp.getDeclinedLeases", params)
 	if err != nil {
 		return 0, err
 	}
@@ -417,10 +495,15 @@ func (s *ServerConnection) DhcpGetDeclinedLeases(scopeIds KIdList) (int, error)
 // DhcpRemoveDeclinedLeases - Removes declined leases defined by scopeIDs param from engine
 //	scopeIds - list of scope IDs or empty for all scopes
 func (s *ServerConnection) DhcpRemoveDeclinedLeases(scopeIds KIdList) error {
+	return s.DhcpRemoveDeclinedLeasesContext(context.Background(), scopeIds)
+}
+
+// DhcpRemoveDeclinedLeasesContext - DhcpRemoveDeclinedLeases with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpRemoveDeclinedLeasesContext(ctx context.Context, scopeIds KIdList) error {
 	params := struct {
 		ScopeIds KIdList `json:"scopeIds"`
 	}{scopeIds}
-	_, err := s.CallRaw("Dhcp.removeDeclinedLeases", params)
+	_, err := s.CallRawContext(ctx, "Dhcp.removeDeclinedLeases", params)
 	return err
 }
 
@@ -428,7 +511,12 @@ func (s *ServerConnection) DhcpRemoveDeclinedLeases(scopeIds KIdList) error {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DhcpApply() (ErrorList, error) {
-	data, err := s.CallRaw("Dhcp.apply", nil)
+	return s.DhcpApplyContext(context.Background())
+}
+
+// DhcpApplyContext - DhcpApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "Dhcp.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -443,6 +531,11 @@ func (s *ServerConnection) DhcpApply() (ErrorList, error) {
 
 // DhcpReset - discard changes cached in manager
 func (s *ServerConnection) DhcpReset() error {
-	_, err := s.CallRaw("Dhcp.reset", nil)
+	return s.DhcpResetContext(context.Background())
+}
+
+// DhcpResetContext - DhcpReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DhcpResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Dhcp.reset", nil)
 	return err
 }
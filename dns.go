@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type DnsForwarder struct {
 	Enabled    bool   `json:"enabled"`
@@ -34,7 +37,12 @@ type DnsHostList []DnsHost
 
 // DnsGet - Returns DNS configuration
 func (s *ServerConnection) DnsGet() (*DnsConfig, error) {
-	data, err := s.CallRaw("Dns.get", nil)
+	return s.DnsGetContext(context.Background())
+}
+
+// DnsGetContext - DnsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsGetContext(ctx context.Context) (*DnsConfig, error) {
+	data, err := s.CallRawContext(ctx, "Dns.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +57,12 @@ func (s *ServerConnection) DnsGet() (*DnsConfig, error) {
 
 // DnsGetHosts - Returns DNS ip/hosts mapping
 func (s *ServerConnection) DnsGetHosts() (DnsHostList, error) {
-	data, err := s.CallRaw("Dns.getHosts", nil)
+	return s.DnsGetHostsContext(context.Background())
+}
+
+// DnsGetHostsContext - DnsGetHosts with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsGetHostsContext(ctx context.Context) (DnsHostList, error) {
+	data, err := s.CallRawContext(ctx, "Dns.getHosts", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -67,10 +80,15 @@ func (s *ServerConnection) DnsGetHosts() (DnsHostList, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DnsSet(config DnsConfig) (ErrorList, error) {
+	return s.DnsSetContext(context.Background(), config)
+}
+
+// DnsSetContext - DnsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsSetContext(ctx context.Context, config DnsConfig) (ErrorList, error) {
 	params := struct {
 		Config DnsConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("Dns.set", params)
+	data, err := s.CallRawContext(ctx, "Dns.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -88,10 +106,15 @@ func (s *ServerConnection) DnsSet(config DnsConfig) (ErrorList, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DnsSetHosts(hosts DnsHostList) (ErrorList, error) {
+	return s.DnsSetHostsContext(context.Background(), hosts)
+}
+
+// DnsSetHostsContext - DnsSetHosts with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsSetHostsContext(ctx context.Context, hosts DnsHostList) (ErrorList, error) {
 	params := struct {
 		Hosts DnsHostList `json:"hosts"`
 	}{hosts}
-	data, err := s.CallRaw("Dns.setHosts", params)
+	data, err := s.CallRawContext(ctx, "Dns.setHosts", params)
 	if err != nil {
 		return nil, err
 	}
@@ -108,16 +131,26 @@ func (s *ServerConnection) DnsSetHosts(hosts DnsHostList) (ErrorList, error) {
 //	fileId - id of uploaded file
 //	clean - true, if current hosts records should be removed, false, if new records should be appended to current config
 func (s *ServerConnection) DnsImportHosts(fileId string, clean bool) error {
+	return s.DnsImportHostsContext(context.Background(), fileId, clean)
+}
+
+// DnsImportHostsContext - DnsImportHosts with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsImportHostsContext(ctx context.Context, fileId string, clean bool) error {
 	params := struct {
 		FileId string `json:"fileId"`
 		Clean  bool   `json:"clean"`
 	}{fileId, clean}
-	_, err := s.CallRaw("Dns.importHosts", params)
+	_, err := s.CallRawContext(ctx, "Dns.importHosts", params)
 	return err
 }
 
 // DnsClearCache - Flushes DNS cache
 func (s *ServerConnection) DnsClearCache() error {
-	_, err := s.CallRaw("Dns.clearCache", nil)
+	return s.DnsClearCacheContext(context.Background())
+}
+
+// DnsClearCacheContext - DnsClearCache with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DnsClearCacheContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Dns.clearCache", nil)
 	return err
 }
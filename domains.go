@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // DirectoryServiceType - Common part, that can be shared between the products
 type DirectoryServiceType string
@@ -66,11 +69,16 @@ type TestResult struct {
 //	list - list of domains and it's details
 //	totalItems - count of all domains on server (before the start/limit applied)
 func (s *ServerConnection) DomainsGet(query SearchQuery) (DomainList, int, error) {
+	return s.DomainsGetContext(context.Background(), query)
+}
+
+// DomainsGetContext - DomainsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsGetContext(ctx context.Context, query SearchQuery) (DomainList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("Domains.get", params)
+	data, err := s.CallRawContext(ctx, "Domains.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -90,10 +98,15 @@ func (s *ServerConnection) DomainsGet(query SearchQuery) (DomainList, int, error
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) DomainsCreate(domains DomainList) (ErrorList, CreateResultList, error) {
+	return s.DomainsCreateContext(context.Background(), domains)
+}
+
+// DomainsCreateContext - DomainsCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsCreateContext(ctx context.Context, domains DomainList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Domains DomainList `json:"domains"`
 	}{domains}
-	data, err := s.CallRaw("Domains.create", params)
+	data, err := s.CallRawContext(ctx, "Domains.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,11 +126,16 @@ func (s *ServerConnection) DomainsCreate(domains DomainList) (ErrorList, CreateR
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DomainsSet(domainIds KIdList, pattern Domain) (ErrorList, error) {
+	return s.DomainsSetContext(context.Background(), domainIds, pattern)
+}
+
+// DomainsSetContext - DomainsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsSetContext(ctx context.Context, domainIds KIdList, pattern Domain) (ErrorList, error) {
 	params := struct {
 		DomainIds KIdList `json:"domainIds"`
 		Pattern   Domain  `json:"pattern"`
 	}{domainIds, pattern}
-	data, err := s.CallRaw("Domains.set", params)
+	data, err := s.CallRawContext(ctx, "Domains.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +153,15 @@ func (s *ServerConnection) DomainsSet(domainIds KIdList, pattern Domain) (ErrorL
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DomainsRemove(domainIds KIdList) (ErrorList, error) {
+	return s.DomainsRemoveContext(context.Background(), domainIds)
+}
+
+// DomainsRemoveContext - DomainsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsRemoveContext(ctx context.Context, domainIds KIdList) (ErrorList, error) {
 	params := struct {
 		DomainIds KIdList `json:"domainIds"`
 	}{domainIds}
-	data, err := s.CallRaw("Domains.remove", params)
+	data, err := s.CallRawContext(ctx, "Domains.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -157,11 +180,16 @@ func (s *ServerConnection) DomainsRemove(domainIds KIdList) (ErrorList, error) {
 // Return
 //	errors - Various error messages related to given data, indexed by values in "hostnames" parameter, contains leading '.'
 func (s *ServerConnection) DomainsTestDomainController(hostnames StringList, directory DirectoryServiceConfiguration) (ErrorList, error) {
+	return s.DomainsTestDomainControllerContext(context.Background(), hostnames, directory)
+}
+
+// DomainsTestDomainControllerContext - DomainsTestDomainController with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsTestDomainControllerContext(ctx context.Context, hostnames StringList, directory DirectoryServiceConfiguration) (ErrorList, error) {
 	params := struct {
 		Hostnames StringList                    `json:"hostnames"`
 		Directory DirectoryServiceConfiguration `json:"directory"`
 	}{hostnames, directory}
-	data, err := s.CallRaw("Domains.testDomainController", params)
+	data, err := s.CallRawContext(ctx, "Domains.testDomainController", params)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +206,12 @@ func (s *ServerConnection) DomainsTestDomainController(hostnames StringList, dir
 // Return
 //	errors - list of errors
 func (s *ServerConnection) DomainsApply() (ErrorList, error) {
-	data, err := s.CallRaw("Domains.apply", nil)
+	return s.DomainsApplyContext(context.Background())
+}
+
+// DomainsApplyContext - DomainsApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "Domains.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +226,11 @@ func (s *ServerConnection) DomainsApply() (ErrorList, error) {
 
 // DomainsReset - discard changes cached in manager
 func (s *ServerConnection) DomainsReset() error {
-	_, err := s.CallRaw("Domains.reset", nil)
+	return s.DomainsResetContext(context.Background())
+}
+
+// DomainsResetContext - DomainsReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DomainsResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Domains.reset", nil)
 	return err
 }
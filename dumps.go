@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type CoreDump struct {
 	Size      ByteValueWithUnits `json:"size"`
@@ -21,7 +24,12 @@ type DumpListWithImportance []CoreDumpWithImportance
 // Return
 //	dumps - list of all available crash dumps
 func (s *ServerConnection) DumpsGet() (DumpList, error) {
-	data, err := s.CallRaw("Dumps.get", nil)
+	return s.DumpsGetContext(context.Background())
+}
+
+// DumpsGetContext - DumpsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DumpsGetContext(ctx context.Context) (DumpList, error) {
+	data, err := s.CallRawContext(ctx, "Dumps.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +46,12 @@ func (s *ServerConnection) DumpsGet() (DumpList, error) {
 // Return
 //	dumps - list of all available crash dumps with importance
 func (s *ServerConnection) DumpsGetWithImportance() (*DumpListWithImportance, error) {
-	data, err := s.CallRaw("Dumps.getWithImportance", nil)
+	return s.DumpsGetWithImportanceContext(context.Background())
+}
+
+// DumpsGetWithImportanceContext - DumpsGetWithImportance with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DumpsGetWithImportanceContext(ctx context.Context) (*DumpListWithImportance, error) {
+	data, err := s.CallRawContext(ctx, "Dumps.getWithImportance", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +66,12 @@ func (s *ServerConnection) DumpsGetWithImportance() (*DumpListWithImportance, er
 
 // DumpsRemove - Remove all crash dumps from server disk
 func (s *ServerConnection) DumpsRemove() error {
-	_, err := s.CallRaw("Dumps.remove", nil)
+	return s.DumpsRemoveContext(context.Background())
+}
+
+// DumpsRemoveContext - DumpsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DumpsRemoveContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Dumps.remove", nil)
 	return err
 }
 
@@ -61,10 +79,15 @@ func (s *ServerConnection) DumpsRemove() error {
 //	description - plain text information to be sent with crash dump
 //	email - contact information to be sent with crash dump
 func (s *ServerConnection) DumpsSend(description string, email string) error {
+	return s.DumpsSendContext(context.Background(), description, email)
+}
+
+// DumpsSendContext - DumpsSend with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DumpsSendContext(ctx context.Context, description string, email string) error {
 	params := struct {
 		Description string `json:"description"`
 		Email       string `json:"email"`
 	}{description, email}
-	_, err := s.CallRaw("Dumps.send", params)
+	_, err := s.CallRawContext(ctx, "Dumps.send", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type DynamicDnsStatus string
 
@@ -31,7 +34,12 @@ type DynamicDnsConfig struct {
 // Return
 //	config - configuration values
 func (s *ServerConnection) DynamicDnsGet() (*DynamicDnsConfig, error) {
-	data, err := s.CallRaw("DynamicDns.get", nil)
+	return s.DynamicDnsGetContext(context.Background())
+}
+
+// DynamicDnsGetContext - DynamicDnsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DynamicDnsGetContext(ctx context.Context) (*DynamicDnsConfig, error) {
+	data, err := s.CallRawContext(ctx, "DynamicDns.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -47,16 +55,26 @@ func (s *ServerConnection) DynamicDnsGet() (*DynamicDnsConfig, error) {
 // DynamicDnsSet - Stores DynDNS configuration
 //	config - configuration values
 func (s *ServerConnection) DynamicDnsSet(config DynamicDnsConfig) error {
+	return s.DynamicDnsSetContext(context.Background(), config)
+}
+
+// DynamicDnsSetContext - DynamicDnsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DynamicDnsSetContext(ctx context.Context, config DynamicDnsConfig) error {
 	params := struct {
 		Config DynamicDnsConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("DynamicDns.set", params)
+	_, err := s.CallRawContext(ctx, "DynamicDns.set", params)
 	return err
 }
 
 // DynamicDnsUpdate - Performs synchronous DynDNS update
 func (s *ServerConnection) DynamicDnsUpdate() error {
-	_, err := s.CallRaw("DynamicDns.update", nil)
+	return s.DynamicDnsUpdateContext(context.Background())
+}
+
+// DynamicDnsUpdateContext - DynamicDnsUpdate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DynamicDnsUpdateContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "DynamicDns.update", nil)
 	return err
 }
 
@@ -65,7 +83,12 @@ func (s *ServerConnection) DynamicDnsUpdate() error {
 //	message - list of errors
 //	status - actual status of DynDNS update
 func (s *ServerConnection) DynamicDnsGetStatus() (*LocalizableMessage, *DynamicDnsStatus, error) {
-	data, err := s.CallRaw("DynamicDns.getStatus", nil)
+	return s.DynamicDnsGetStatusContext(context.Background())
+}
+
+// DynamicDnsGetStatusContext - DynamicDnsGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DynamicDnsGetStatusContext(ctx context.Context) (*LocalizableMessage, *DynamicDnsStatus, error) {
+	data, err := s.CallRawContext(ctx, "DynamicDns.getStatus", nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +106,12 @@ func (s *ServerConnection) DynamicDnsGetStatus() (*LocalizableMessage, *DynamicD
 // Return
 //	providers - return values
 func (s *ServerConnection) DynamicDnsGetProviders() (StringList, error) {
-	data, err := s.CallRaw("DynamicDns.getProviders", nil)
+	return s.DynamicDnsGetProvidersContext(context.Background())
+}
+
+// DynamicDnsGetProvidersContext - DynamicDnsGetProviders with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) DynamicDnsGetProvidersContext(ctx context.Context) (StringList, error) {
+	data, err := s.CallRawContext(ctx, "DynamicDns.getProviders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,30 @@ package control
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error codes reported by the server in ErrorReport.Code
+const (
+	CodeParseError      = -32700 // invalid JSON was received by the server
+	CodeInvalidRequest  = -32600 // the JSON sent is not a valid request object
+	CodeMethodNotFound  = -32601 // the method does not exist or is not available
+	CodeInvalidParams   = -32602 // invalid method parameters
+	CodeInternalError   = -32603 // internal JSON-RPC error
+	CodeSessionExpired  = -32001 // session expired or token is not valid
+	CodeOperationFailed = 1000   // generic failure of the operation
+	CodeAlreadyExists   = 1001   // the entity already exists
+	CodeNotFound        = 1002   // no such entity
+	CodeAccessDenied    = 1003   // operation is not permitted for the logged user
+)
+
+// Sentinel errors for use with errors.Is, matched by error code
+var (
+	ErrSessionExpired = &APIError{Code: CodeSessionExpired, Message: "session expired"}
+	ErrAccessDenied   = &APIError{Code: CodeAccessDenied, Message: "access denied"}
+	ErrNotFound       = &APIError{Code: CodeNotFound, Message: "not found"}
+	ErrInvalidParams  = &APIError{Code: CodeInvalidParams, Message: "invalid params"}
 )
 
 type ErrorReport struct {
@@ -20,11 +44,69 @@ type errorReport struct {
 	ErrorReport `json:"error"`
 }
 
+// APIError - error returned by the server
+type APIError struct {
+	Code                 int      // error code, see Code* constants
+	Message              string   // localizable message with substituted positional parameters
+	Template             string   // message as reported by the server, may contain placeholders %1, %2...
+	PositionalParameters []string // parameters of the localizable message
+	Plurality            int      // plurality of the localizable message
+}
+
+// Error returns the code and the message of the error
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// Is reports whether target is an *APIError with the same code
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+func newAPIError(report ErrorReport) *APIError {
+	params := report.Data.MessageParameters
+	return &APIError{
+		Code:                 report.Code,
+		Message:              substituteParameters(report.Message, params.PositionalParameters),
+		Template:             report.Message,
+		PositionalParameters: params.PositionalParameters,
+		Plurality:            params.Plurality,
+	}
+}
+
+// report returns ErrorReport the error was created from
+func (e *APIError) report() *ErrorReport {
+	report := &ErrorReport{Code: e.Code, Message: e.Template}
+	report.Data.MessageParameters.PositionalParameters = e.PositionalParameters
+	report.Data.MessageParameters.Plurality = e.Plurality
+	return report
+}
+
+// substituteParameters replaces placeholders %1, %2... in message by positional parameters
+func substituteParameters(message string, parameters []string) string {
+	// replace from the last one, so that %1 does not break %10
+	for i := len(parameters); i > 0; i-- {
+		message = strings.ReplaceAll(message, "%"+strconv.Itoa(i), parameters[i-1])
+	}
+	return message
+}
+
 func checkError(data []byte) error {
 	errorReport := errorReport{}
 	_ = json.Unmarshal(data, &errorReport)
 	if errorReport.Code == 0 && errorReport.Message == "" {
 		return nil
 	}
-	return fmt.Errorf("%d: %s", errorReport.Code, errorReport.Message)
+	return newAPIError(errorReport.ErrorReport)
+}
+
+// StatusError - HTTP response with error status not containing JSON-RPC response
+type StatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return "unexpected HTTP response: " + e.Status
 }
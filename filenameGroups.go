@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type OptionalStringList struct {
 	Enabled bool       `json:"enabled"`
@@ -132,7 +135,12 @@ type FilenameGroupList []FilenameGroup
 // Return
 //	groups - list of filename groups
 func (s *ServerConnection) FilenameGroupsGet() (FilenameGroupList, error) {
-	data, err := s.CallRaw("FilenameGroups.get", nil)
+	return s.FilenameGroupsGetContext(context.Background())
+}
+
+// FilenameGroupsGetContext - FilenameGroupsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) FilenameGroupsGetContext(ctx context.Context) (FilenameGroupList, error) {
+	data, err := s.CallRawContext(ctx, "FilenameGroups.get", nil)
 	if err != nil {
 		return nil, err
 	}
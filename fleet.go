@@ -0,0 +1,232 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Appliance - server managed by Fleet
+type Appliance struct {
+	Name        string
+	Labels      map[string]string
+	Conn        *ServerConnection
+	credentials CredentialsFunc
+	application *ApiApplication
+	loginMu     sync.Mutex
+}
+
+// Fleet - set of servers operations are run on in parallel with bounded concurrency.
+// Fleet is safe for concurrent use once all appliances are added.
+type Fleet struct {
+	appliances  []*Appliance
+	concurrency int
+}
+
+// Selector chooses appliances an operation is run on
+type Selector func(a *Appliance) bool
+
+// Operation is run on an appliance by Fleet.Run; the connection of the appliance is logged in
+type Operation func(ctx context.Context, a *Appliance) (interface{}, error)
+
+// FleetResult - result of an operation on one appliance
+type FleetResult struct {
+	Appliance *Appliance
+	Value     interface{} // value returned by the operation
+	Err       error
+	Duration  time.Duration
+}
+
+// FleetReport - results of an operation on all selected appliances, in order of adding of the appliances
+type FleetReport struct {
+	Results []FleetResult
+}
+
+// NewFleet returns an empty fleet running an operation on at most concurrency appliances at once,
+// zero or negative concurrency means no limit
+func NewFleet(concurrency int) *Fleet {
+	return &Fleet{concurrency: concurrency}
+}
+
+// Add adds an appliance to the fleet. Its connection logs in with credentials before the first operation
+// and again when the session expires (see ServerConnection.SetRelogin).
+//	name - unique name of the appliance
+//	config - configuration for connecting to the appliance
+//	credentials - provider of the user name and password, see StaticCredentials; must not be nil
+//	application - client application description
+//	labels - labels used for selection of appliances, may be nil
+func (f *Fleet) Add(name string, config *Config, credentials CredentialsFunc, application *ApiApplication, labels map[string]string) (*Appliance, error) {
+	if credentials == nil {
+		return nil, fmt.Errorf("appliance %s has no credentials", name)
+	}
+	if f.Appliance(name) != nil {
+		return nil, fmt.Errorf("appliance %s already added", name)
+	}
+	conn, err := config.NewConnection()
+	if err != nil {
+		return nil, err
+	}
+	conn.SetRelogin(credentials, application)
+	a := &Appliance{
+		Name:        name,
+		Labels:      labels,
+		Conn:        conn,
+		credentials: credentials,
+		application: application,
+	}
+	f.appliances = append(f.appliances, a)
+	return a, nil
+}
+
+// Appliance returns appliance with name, nil if there is no such appliance
+func (f *Fleet) Appliance(name string) *Appliance {
+	for _, a := range f.appliances {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// Appliances returns all appliances of the fleet
+func (f *Fleet) Appliances() []*Appliance {
+	return append([]*Appliance{}, f.appliances...)
+}
+
+// AllAppliances selects all appliances
+func AllAppliances(*Appliance) bool {
+	return true
+}
+
+// WithLabel selects appliances with label key set to value
+func WithLabel(key, value string) Selector {
+	return func(a *Appliance) bool {
+		v, ok := a.Labels[key]
+		return ok && v == value
+	}
+}
+
+// ApplianceNamed selects appliances with given names
+func ApplianceNamed(names ...string) Selector {
+	return func(a *Appliance) bool {
+		for _, name := range names {
+			if a.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Run runs op on appliances chosen by selector (all if nil) in parallel and collects the results.
+// Appliances not logged in yet are logged in first. Cancelling ctx stops starting of the operation
+// on further appliances, their results contain the error of ctx.
+func (f *Fleet) Run(ctx context.Context, selector Selector, op Operation) *FleetReport {
+	if selector == nil {
+		selector = AllAppliances
+	}
+	var selected []*Appliance
+	for _, a := range f.appliances {
+		if selector(a) {
+			selected = append(selected, a)
+		}
+	}
+	report := &FleetReport{Results: make([]FleetResult, len(selected))}
+	concurrency := f.concurrency
+	if concurrency <= 0 || concurrency > len(selected) {
+		concurrency = len(selected)
+	}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, a := range selected {
+		report.Results[i].Appliance = a
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			report.Results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(result *FleetResult) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			start := time.Now()
+			if err := result.Appliance.login(ctx); err != nil {
+				result.Err = err
+			} else {
+				result.Value, result.Err = op(ctx, result.Appliance)
+			}
+			result.Duration = time.Since(start)
+		}(&report.Results[i])
+	}
+	wg.Wait()
+	return report
+}
+
+// Logout logs out appliances chosen by selector (all if nil)
+func (f *Fleet) Logout(ctx context.Context, selector Selector) *FleetReport {
+	if selector == nil {
+		selector = AllAppliances
+	}
+	return f.Run(ctx, func(a *Appliance) bool {
+		return selector(a) && a.Conn.token() != nil
+	}, func(ctx context.Context, a *Appliance) (interface{}, error) {
+		err := a.Conn.LogoutContext(ctx)
+		a.Conn.setToken(nil)
+		return nil, err
+	})
+}
+
+// login logs in the appliance, unless it is logged in already
+func (a *Appliance) login(ctx context.Context) error {
+	a.loginMu.Lock()
+	defer a.loginMu.Unlock()
+	if a.Conn.token() != nil {
+		return nil
+	}
+	userName, password, err := a.credentials(ctx)
+	if err != nil {
+		return err
+	}
+	return a.Conn.LoginContext(ctx, userName, password, a.application)
+}
+
+// Succeeded returns results without error
+func (r *FleetReport) Succeeded() []FleetResult {
+	return r.filter(func(result *FleetResult) bool { return result.Err == nil })
+}
+
+// Failed returns results with error
+func (r *FleetReport) Failed() []FleetResult {
+	return r.filter(func(result *FleetResult) bool { return result.Err != nil })
+}
+
+func (r *FleetReport) filter(match func(result *FleetResult) bool) []FleetResult {
+	var results []FleetResult
+	for i := range r.Results {
+		if match(&r.Results[i]) {
+			results = append(results, r.Results[i])
+		}
+	}
+	return results
+}
+
+// Err returns error describing all failed appliances, nil if the operation succeeded everywhere
+func (r *FleetReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	messages := make([]string, len(failed))
+	for i, result := range failed {
+		messages[i] = result.Appliance.Name + ": " + result.Err.Error()
+	}
+	sort.Strings(messages)
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,142 @@
+package control_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// newFleet returns fleet of appliances a, b and c with label site, the password of c is wrong
+func newFleet(t *testing.T, concurrency int) *control.Fleet {
+	fleet := control.NewFleet(concurrency)
+	for _, appliance := range []struct {
+		name, site, password string
+	}{
+		{"a", "prague", controltest.Password},
+		{"b", "brno", controltest.Password},
+		{"c", "prague", "wrong"},
+	} {
+		fake := controltest.NewServer()
+		t.Cleanup(fake.Close)
+		credentials := control.StaticCredentials(controltest.UserName, appliance.password)
+		labels := map[string]string{"site": appliance.site}
+		if _, err := fleet.Add(appliance.name, fake.Config(), credentials, nil, labels); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fleet
+}
+
+// userName is an Operation returning name of the logged user
+func userName(ctx context.Context, a *control.Appliance) (interface{}, error) {
+	return a.Conn.SessionGetUserNameContext(ctx)
+}
+
+func TestFleetAdd(t *testing.T) {
+	fleet := newFleet(t, 0)
+	fake := controltest.NewServer()
+	defer fake.Close()
+	credentials := control.StaticCredentials(controltest.UserName, controltest.Password)
+	if _, err := fleet.Add("a", fake.Config(), credentials, nil, nil); err == nil {
+		t.Error("got no error for a duplicate name")
+	}
+	if _, err := fleet.Add("d", fake.Config(), nil, nil, nil); err == nil {
+		t.Error("got no error for nil credentials")
+	}
+	if len(fleet.Appliances()) != 3 || fleet.Appliance("b") == nil || fleet.Appliance("d") != nil {
+		t.Errorf("got appliances %v, expected a, b and c", fleet.Appliances())
+	}
+}
+
+func TestFleetRun(t *testing.T) {
+	fleet := newFleet(t, 0)
+	report := fleet.Run(context.Background(), nil, userName)
+	if len(report.Results) != 3 {
+		t.Fatalf("got %d results, expected 3", len(report.Results))
+	}
+	for i, name := range []string{"a", "b", "c"} {
+		if result := report.Results[i]; result.Appliance.Name != name {
+			t.Errorf("result %d: got appliance %s, expected %s", i, result.Appliance.Name, name)
+		}
+	}
+	succeeded, failed := report.Succeeded(), report.Failed()
+	if len(succeeded) != 2 || succeeded[0].Value != controltest.UserName || succeeded[1].Value != controltest.UserName {
+		t.Errorf("got succeeded %+v, expected a and b", succeeded)
+	}
+	if len(failed) != 1 || failed[0].Appliance.Name != "c" || !errors.Is(failed[0].Err, control.ErrAccessDenied) {
+		t.Errorf("got failed %+v, expected c denied", failed)
+	}
+	if err := report.Err(); err == nil || !strings.HasPrefix(err.Error(), "c: ") {
+		t.Errorf("got %v, expected the error of c", err)
+	}
+
+	report = fleet.Run(context.Background(), control.WithLabel("site", "prague"), userName)
+	if len(report.Results) != 2 || report.Results[0].Appliance.Name != "a" || report.Results[1].Appliance.Name != "c" {
+		t.Errorf("got %+v, expected appliances of prague", report.Results)
+	}
+	report = fleet.Run(context.Background(), control.ApplianceNamed("b", "x"), userName)
+	if len(report.Results) != 1 || report.Results[0].Appliance.Name != "b" || report.Err() != nil {
+		t.Errorf("got %+v, expected b", report.Results)
+	}
+}
+
+func TestFleetConcurrency(t *testing.T) {
+	fleet := newFleet(t, 2)
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	report := fleet.Run(context.Background(), control.AllAppliances, func(context.Context, *control.Appliance) (interface{}, error) {
+		mu.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return nil, nil
+	})
+	// the operation is not run on c failing to log in
+	if len(report.Succeeded()) != 2 || maxActive != 2 {
+		t.Errorf("got %d succeeded and %d running at once, expected 2 and 2", len(report.Succeeded()), maxActive)
+	}
+}
+
+func TestFleetCancel(t *testing.T) {
+	fleet := newFleet(t, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report := fleet.Run(ctx, nil, func(context.Context, *control.Appliance) (interface{}, error) {
+		t.Error("operation run after cancel")
+		return nil, nil
+	})
+	for _, result := range report.Results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("%s: got %v, expected canceled", result.Appliance.Name, result.Err)
+		}
+	}
+}
+
+func TestFleetLogout(t *testing.T) {
+	fleet := newFleet(t, 0)
+	fleet.Run(context.Background(), nil, userName)
+	// c is not logged in, so it is not logged out
+	report := fleet.Logout(context.Background(), nil)
+	if len(report.Results) != 2 || report.Err() != nil {
+		t.Errorf("got %+v, expected a and b logged out", report.Results)
+	}
+	if report = fleet.Logout(context.Background(), nil); len(report.Results) != 0 {
+		t.Errorf("got %+v, expected nothing to log out", report.Results)
+	}
+	// appliances log in again
+	if report = fleet.Run(context.Background(), control.ApplianceNamed("a"), userName); report.Err() != nil {
+		t.Error(report.Err())
+	}
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // ForbiddenWord - Forbidden words
 type ForbiddenWord struct {
@@ -34,11 +37,16 @@ type ForbiddenWordsConfig struct {
 //	list - list of words and it's details
 //	totalItems - count of all words on server (before the start/limit applied)
 func (s *ServerConnection) ForbiddenWordsGet(query SearchQuery) (ForbiddenWordList, int, error) {
+	return s.ForbiddenWordsGetContext(context.Background(), query)
+}
+
+// ForbiddenWordsGetContext - ForbiddenWordsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsGetContext(ctx context.Context, query SearchQuery) (ForbiddenWordList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("ForbiddenWords.get", params)
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -58,10 +66,15 @@ func (s *ServerConnection) ForbiddenWordsGet(query SearchQuery) (ForbiddenWordLi
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) ForbiddenWordsCreate(items ForbiddenWordList) (ErrorList, CreateResultList, error) {
+	return s.ForbiddenWordsCreateContext(context.Background(), items)
+}
+
+// ForbiddenWordsCreateContext - ForbiddenWordsCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsCreateContext(ctx context.Context, items ForbiddenWordList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Items ForbiddenWordList `json:"items"`
 	}{items}
-	data, err := s.CallRaw("ForbiddenWords.create", params)
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,11 +94,16 @@ func (s *ServerConnection) ForbiddenWordsCreate(items ForbiddenWordList) (ErrorL
 // Return
 //	errors - list of errors
 func (s *ServerConnection) ForbiddenWordsSet(ids StringList, details ForbiddenWord) (ErrorList, error) {
+	return s.ForbiddenWordsSetContext(context.Background(), ids, details)
+}
+
+// ForbiddenWordsSetContext - ForbiddenWordsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsSetContext(ctx context.Context, ids StringList, details ForbiddenWord) (ErrorList, error) {
 	params := struct {
 		Ids     StringList    `json:"ids"`
 		Details ForbiddenWord `json:"details"`
 	}{ids, details}
-	data, err := s.CallRaw("ForbiddenWords.set", params)
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -103,10 +121,15 @@ func (s *ServerConnection) ForbiddenWordsSet(ids StringList, details ForbiddenWo
 // Return
 //	errors - list of errors
 func (s *ServerConnection) ForbiddenWordsRemove(ids StringList) (ErrorList, error) {
+	return s.ForbiddenWordsRemoveContext(context.Background(), ids)
+}
+
+// ForbiddenWordsRemoveContext - ForbiddenWordsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsRemoveContext(ctx context.Context, ids StringList) (ErrorList, error) {
 	params := struct {
 		Ids StringList `json:"ids"`
 	}{ids}
-	data, err := s.CallRaw("ForbiddenWords.remove", params)
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +146,12 @@ func (s *ServerConnection) ForbiddenWordsRemove(ids StringList) (ErrorList, erro
 // Return
 //	errors - list of errors
 func (s *ServerConnection) ForbiddenWordsApply() (ErrorList, error) {
-	data, err := s.CallRaw("ForbiddenWords.apply", nil)
+	return s.ForbiddenWordsApplyContext(context.Background())
+}
+
+// ForbiddenWordsApplyContext - ForbiddenWordsApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +166,12 @@ func (s *ServerConnection) ForbiddenWordsApply() (ErrorList, error) {
 
 // ForbiddenWordsReset - Discard changes cached in manager
 func (s *ServerConnection) ForbiddenWordsReset() error {
-	_, err := s.CallRaw("ForbiddenWords.reset", nil)
+	return s.ForbiddenWordsResetContext(context.Background())
+}
+
+// ForbiddenWordsResetContext - ForbiddenWordsReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "ForbiddenWords.reset", nil)
 	return err
 }
 
@@ -146,7 +179,12 @@ func (s *ServerConnection) ForbiddenWordsReset() error {
 // Return
 //	config - Complete configuration of Forbidden words module
 func (s *ServerConnection) ForbiddenWordsGetConfig() (*ForbiddenWordsConfig, error) {
-	data, err := s.CallRaw("ForbiddenWords.getConfig", nil)
+	return s.ForbiddenWordsGetConfigContext(context.Background())
+}
+
+// ForbiddenWordsGetConfigContext - ForbiddenWordsGetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsGetConfigContext(ctx context.Context) (*ForbiddenWordsConfig, error) {
+	data, err := s.CallRawContext(ctx, "ForbiddenWords.getConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -162,9 +200,14 @@ func (s *ServerConnection) ForbiddenWordsGetConfig() (*ForbiddenWordsConfig, err
 // ForbiddenWordsSetConfig - Stores the Weight Limit/Enabled
 //	config - Complete configuration of Forbidden words module
 func (s *ServerConnection) ForbiddenWordsSetConfig(config ForbiddenWordsConfig) error {
+	return s.ForbiddenWordsSetConfigContext(context.Background(), config)
+}
+
+// ForbiddenWordsSetConfigContext - ForbiddenWordsSetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ForbiddenWordsSetConfigContext(ctx context.Context, config ForbiddenWordsConfig) error {
 	params := struct {
 		Config ForbiddenWordsConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ForbiddenWords.setConfig", params)
+	_, err := s.CallRawContext(ctx, "ForbiddenWords.setConfig", params)
 	return err
 }
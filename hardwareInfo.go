@@ -1,12 +1,20 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // HardwareInfoGetBoxSerialNumber - Return information about serialnumber of hardware box
 // Return
 //  serialNumber - serialnumber of hardware box
 func (s *ServerConnection) HardwareInfoGetBoxSerialNumber() (string, error) {
-	data, err := s.CallRaw("HardwareInfo.getBoxSerialNumber", nil)
+	return s.HardwareInfoGetBoxSerialNumberContext(context.Background())
+}
+
+// HardwareInfoGetBoxSerialNumberContext - HardwareInfoGetBoxSerialNumber with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HardwareInfoGetBoxSerialNumberContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "HardwareInfo.getBoxSerialNumber", nil)
 	if err != nil {
 		return "", err
 	}
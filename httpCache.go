@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type HttpCacheStatus struct {
 	Used  float64 `json:"used"` // in B
@@ -30,7 +33,12 @@ type UrlSpecificTtlList []UrlSpecificTtl
 // Return
 //	config - current configuration
 func (s *ServerConnection) HttpCacheGet() (*HttpCacheConfig, error) {
-	data, err := s.CallRaw("HttpCache.get", nil)
+	return s.HttpCacheGetContext(context.Background())
+}
+
+// HttpCacheGetContext - HttpCacheGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HttpCacheGetContext(ctx context.Context) (*HttpCacheConfig, error) {
+	data, err := s.CallRawContext(ctx, "HttpCache.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +54,15 @@ func (s *ServerConnection) HttpCacheGet() (*HttpCacheConfig, error) {
 // HttpCacheSet - Sets Http Cache configuration
 //	config - new configuration
 func (s *ServerConnection) HttpCacheSet(config HttpCacheConfig) error {
+	return s.HttpCacheSetContext(context.Background(), config)
+}
+
+// HttpCacheSetContext - HttpCacheSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HttpCacheSetContext(ctx context.Context, config HttpCacheConfig) error {
 	params := struct {
 		Config HttpCacheConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("HttpCache.set", params)
+	_, err := s.CallRawContext(ctx, "HttpCache.set", params)
 	return err
 }
 
@@ -57,7 +70,12 @@ func (s *ServerConnection) HttpCacheSet(config HttpCacheConfig) error {
 // Return
 //	list - URL specific TTL list
 func (s *ServerConnection) HttpCacheGetUrlSpecificTtl() (UrlSpecificTtlList, error) {
-	data, err := s.CallRaw("HttpCache.getUrlSpecificTtl", nil)
+	return s.HttpCacheGetUrlSpecificTtlContext(context.Background())
+}
+
+// HttpCacheGetUrlSpecificTtlContext - HttpCacheGetUrlSpecificTtl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HttpCacheGetUrlSpecificTtlContext(ctx context.Context) (UrlSpecificTtlList, error) {
+	data, err := s.CallRawContext(ctx, "HttpCache.getUrlSpecificTtl", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +93,15 @@ func (s *ServerConnection) HttpCacheGetUrlSpecificTtl() (UrlSpecificTtlList, err
 // Return
 //	errors - list of errors
 func (s *ServerConnection) HttpCacheSetUrlSpecificTtl(list UrlSpecificTtlList) (ErrorList, error) {
+	return s.HttpCacheSetUrlSpecificTtlContext(context.Background(), list)
+}
+
+// HttpCacheSetUrlSpecificTtlContext - HttpCacheSetUrlSpecificTtl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HttpCacheSetUrlSpecificTtlContext(ctx context.Context, list UrlSpecificTtlList) (ErrorList, error) {
 	params := struct {
 		List UrlSpecificTtlList `json:"list"`
 	}{list}
-	data, err := s.CallRaw("HttpCache.setUrlSpecificTtl", params)
+	data, err := s.CallRawContext(ctx, "HttpCache.setUrlSpecificTtl", params)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +116,11 @@ func (s *ServerConnection) HttpCacheSetUrlSpecificTtl(list UrlSpecificTtlList) (
 
 // HttpCacheClearCache - Removes cache content
 func (s *ServerConnection) HttpCacheClearCache() error {
-	_, err := s.CallRaw("HttpCache.clearCache", nil)
+	return s.HttpCacheClearCacheContext(context.Background())
+}
+
+// HttpCacheClearCacheContext - HttpCacheClearCache with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) HttpCacheClearCacheContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "HttpCache.clearCache", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // Inspector - Protocol Inspector - instances defined at compilation time, unchangeable
 type Inspector struct {
@@ -14,7 +17,12 @@ type InspectorList []Inspector
 // Return
 //	list - list of inspectors and it's details
 func (s *ServerConnection) InspectorsGet() (InspectorList, error) {
-	data, err := s.CallRaw("Inspectors.get", nil)
+	return s.InspectorsGetContext(context.Background())
+}
+
+// InspectorsGetContext - InspectorsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InspectorsGetContext(ctx context.Context) (InspectorList, error) {
+	data, err := s.CallRawContext(ctx, "Inspectors.get", nil)
 	if err != nil {
 		return nil, err
 	}
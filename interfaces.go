@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type InterfaceType string
 
@@ -321,12 +324,17 @@ type IpCollisionList []KIdList
 // InterfacesGet - Obtain list of interfaces.
 // When sortByGroup is true and sorting is 'name', sorting order is 'group', 'type', 'name'
 func (s *ServerConnection) InterfacesGet(query SearchQuery, sortByGroup bool) (InterfaceList, int, error) {
+	return s.InterfacesGetContext(context.Background(), query, sortByGroup)
+}
+
+// InterfacesGetContext - InterfacesGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesGetContext(ctx context.Context, query SearchQuery, sortByGroup bool) (InterfaceList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query       SearchQuery `json:"query"`
 		SortByGroup bool        `json:"sortByGroup"`
 	}{query, sortByGroup}
-	data, err := s.CallRaw("Interfaces.get", params)
+	data, err := s.CallRawContext(ctx, "Interfaces.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -346,10 +354,15 @@ func (s *ServerConnection) InterfacesGet(query SearchQuery, sortByGroup bool) (I
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) InterfacesCreate(list InterfaceList) (ErrorList, CreateResultList, error) {
+	return s.InterfacesCreateContext(context.Background(), list)
+}
+
+// InterfacesCreateContext - InterfacesCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesCreateContext(ctx context.Context, list InterfaceList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		List InterfaceList `json:"list"`
 	}{list}
-	data, err := s.CallRaw("Interfaces.create", params)
+	data, err := s.CallRawContext(ctx, "Interfaces.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -369,11 +382,16 @@ func (s *ServerConnection) InterfacesCreate(list InterfaceList) (ErrorList, Crea
 // Return
 //	errors - list of errors
 func (s *ServerConnection) InterfacesSet(ids KIdList, details Interface) (ErrorList, error) {
+	return s.InterfacesSetContext(context.Background(), ids, details)
+}
+
+// InterfacesSetContext - InterfacesSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesSetContext(ctx context.Context, ids KIdList, details Interface) (ErrorList, error) {
 	params := struct {
 		Ids     KIdList   `json:"ids"`
 		Details Interface `json:"details"`
 	}{ids, details}
-	data, err := s.CallRaw("Interfaces.set", params)
+	data, err := s.CallRawContext(ctx, "Interfaces.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -391,10 +409,15 @@ func (s *ServerConnection) InterfacesSet(ids KIdList, details Interface) (ErrorL
 // Return
 //	errors - list of errors
 func (s *ServerConnection) InterfacesRemove(ids KIdList) (ErrorList, error) {
+	return s.InterfacesRemoveContext(context.Background(), ids)
+}
+
+// InterfacesRemoveContext - InterfacesRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesRemoveContext(ctx context.Context, ids KIdList) (ErrorList, error) {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	data, err := s.CallRaw("Interfaces.remove", params)
+	data, err := s.CallRawContext(ctx, "Interfaces.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +434,12 @@ func (s *ServerConnection) InterfacesRemove(ids KIdList) (ErrorList, error) {
 // Return
 //  collisions - list of ip collision
 func (s *ServerConnection) InterfacesCheckIpCollision() (IpCollisionList, error) {
-	data, err := s.CallRaw("Interfaces.checkIpCollision", nil)
+	return s.InterfacesCheckIpCollisionContext(context.Background())
+}
+
+// InterfacesCheckIpCollisionContext - InterfacesCheckIpCollision with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesCheckIpCollisionContext(ctx context.Context) (IpCollisionList, error) {
+	data, err := s.CallRawContext(ctx, "Interfaces.checkIpCollision", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -428,7 +456,12 @@ func (s *ServerConnection) InterfacesCheckIpCollision() (IpCollisionList, error)
 // Return
 //  warnings - list of notification type
 func (s *ServerConnection) InterfacesGetWarnings() (NotificationTypeList, error) {
-	data, err := s.CallRaw("Interfaces.getWarnings", nil)
+	return s.InterfacesGetWarningsContext(context.Background())
+}
+
+// InterfacesGetWarningsContext - InterfacesGetWarnings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesGetWarningsContext(ctx context.Context) (NotificationTypeList, error) {
+	data, err := s.CallRawContext(ctx, "Interfaces.getWarnings", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +478,12 @@ func (s *ServerConnection) InterfacesGetWarnings() (NotificationTypeList, error)
 // Return
 //	config - Connectivity config values
 func (s *ServerConnection) InterfacesGetConnectivityConfig() (*ConnectivityConfig, error) {
-	data, err := s.CallRaw("Interfaces.getConnectivityConfig", nil)
+	return s.InterfacesGetConnectivityConfigContext(context.Background())
+}
+
+// InterfacesGetConnectivityConfigContext - InterfacesGetConnectivityConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesGetConnectivityConfigContext(ctx context.Context) (*ConnectivityConfig, error) {
+	data, err := s.CallRawContext(ctx, "Interfaces.getConnectivityConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -461,16 +499,26 @@ func (s *ServerConnection) InterfacesGetConnectivityConfig() (*ConnectivityConfi
 // InterfacesSetConnectivityConfig - Stores Connectivity config values
 //	config - Connectivity config values
 func (s *ServerConnection) InterfacesSetConnectivityConfig(config ConnectivityConfig) error {
+	return s.InterfacesSetConnectivityConfigContext(context.Background(), config)
+}
+
+// InterfacesSetConnectivityConfigContext - InterfacesSetConnectivityConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesSetConnectivityConfigContext(ctx context.Context, config ConnectivityConfig) error {
 	params := struct {
 		Config ConnectivityConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("Interfaces.setConnectivityConfig", params)
+	_, err := s.CallRawContext(ctx, "Interfaces.setConnectivityConfig", params)
 	return err
 }
 
 // InterfacesStartConnectivityTest - Initiates testing of connectivity
 func (s *ServerConnection) InterfacesStartConnectivityTest() error {
-	_, err := s.CallRaw("Interfaces.startConnectivityTest", nil)
+	return s.InterfacesStartConnectivityTestContext(context.Background())
+}
+
+// InterfacesStartConnectivityTestContext - InterfacesStartConnectivityTest with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesStartConnectivityTestContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Interfaces.startConnectivityTest", nil)
 	return err
 }
 
@@ -478,7 +526,12 @@ func (s *ServerConnection) InterfacesStartConnectivityTest() error {
 // Return
 //	status - actual status
 func (s *ServerConnection) InterfacesConnectivityTestStatus() (*ConnectivityStatus, error) {
-	data, err := s.CallRaw("Interfaces.connectivityTestStatus", nil)
+	return s.InterfacesConnectivityTestStatusContext(context.Background())
+}
+
+// InterfacesConnectivityTestStatusContext - InterfacesConnectivityTestStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesConnectivityTestStatusContext(ctx context.Context) (*ConnectivityStatus, error) {
+	data, err := s.CallRawContext(ctx, "Interfaces.connectivityTestStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -493,26 +546,41 @@ func (s *ServerConnection) InterfacesConnectivityTestStatus() (*ConnectivityStat
 
 // InterfacesCancelConnectivityTest - Cancels testing of connectivity nad sets status to ConnectivityError
 func (s *ServerConnection) InterfacesCancelConnectivityTest() error {
-	_, err := s.CallRaw("Interfaces.cancelConnectivityTest", nil)
+	return s.InterfacesCancelConnectivityTestContext(context.Background())
+}
+
+// InterfacesCancelConnectivityTestContext - InterfacesCancelConnectivityTest with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesCancelConnectivityTestContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Interfaces.cancelConnectivityTest", nil)
 	return err
 }
 
 // InterfacesDial - Dial interface. Works only for disconnected RAS. Action is taken immediatelly, without apply.
 func (s *ServerConnection) InterfacesDial(id KId) error {
+	return s.InterfacesDialContext(context.Background(), id)
+}
+
+// InterfacesDialContext - InterfacesDial with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesDialContext(ctx context.Context, id KId) error {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	_, err := s.CallRaw("Interfaces.dial", params)
+	_, err := s.CallRawContext(ctx, "Interfaces.dial", params)
 	return err
 }
 
 // InterfacesHangup - Hangup interface. Works only for connected RAS. Action is taken immediatelly, without apply.
 //	id - interface id
 func (s *ServerConnection) InterfacesHangup(id KId) error {
+	return s.InterfacesHangupContext(context.Background(), id)
+}
+
+// InterfacesHangupContext - InterfacesHangup with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesHangupContext(ctx context.Context, id KId) error {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	_, err := s.CallRaw("Interfaces.hangup", params)
+	_, err := s.CallRawContext(ctx, "Interfaces.hangup", params)
 	return err
 }
 
@@ -520,7 +588,12 @@ func (s *ServerConnection) InterfacesHangup(id KId) error {
 // Return
 //	config - values to be displayed on VPN Tunnel IPsec dialog as peer ID config
 func (s *ServerConnection) InterfacesGetIpsecPeerIdConfig() (*IpsecPeerIdConfig, error) {
-	data, err := s.CallRaw("Interfaces.getIpsecPeerIdConfig", nil)
+	return s.InterfacesGetIpsecPeerIdConfigContext(context.Background())
+}
+
+// InterfacesGetIpsecPeerIdConfigContext - InterfacesGetIpsecPeerIdConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesGetIpsecPeerIdConfigContext(ctx context.Context) (*IpsecPeerIdConfig, error) {
+	data, err := s.CallRawContext(ctx, "Interfaces.getIpsecPeerIdConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -538,10 +611,15 @@ func (s *ServerConnection) InterfacesGetIpsecPeerIdConfig() (*IpsecPeerIdConfig,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) InterfacesApply(revertTimeout int) (ErrorList, error) {
+	return s.InterfacesApplyContext(context.Background(), revertTimeout)
+}
+
+// InterfacesApplyContext - InterfacesApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesApplyContext(ctx context.Context, revertTimeout int) (ErrorList, error) {
 	params := struct {
 		RevertTimeout int `json:"revertTimeout"`
 	}{revertTimeout}
-	data, err := s.CallRaw("Interfaces.apply", params)
+	data, err := s.CallRawContext(ctx, "Interfaces.apply", params)
 	if err != nil {
 		return nil, err
 	}
@@ -556,6 +634,11 @@ func (s *ServerConnection) InterfacesApply(revertTimeout int) (ErrorList, error)
 
 // InterfacesReset - discard changes cached in manager
 func (s *ServerConnection) InterfacesReset() error {
-	_, err := s.CallRaw("Interfaces.reset", nil)
+	return s.InterfacesResetContext(context.Background())
+}
+
+// InterfacesResetContext - InterfacesReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) InterfacesResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Interfaces.reset", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 const UpdateTimeNever int = 0
 
@@ -57,7 +60,12 @@ type IntrusionPreventionConfig struct {
 // Return
 //	config - complete configuration of Intrusion Prevention system
 func (s *ServerConnection) IntrusionPreventionGet() (*IntrusionPreventionConfig, error) {
-	data, err := s.CallRaw("IntrusionPrevention.get", nil)
+	return s.IntrusionPreventionGetContext(context.Background())
+}
+
+// IntrusionPreventionGetContext - IntrusionPreventionGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionGetContext(ctx context.Context) (*IntrusionPreventionConfig, error) {
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +83,15 @@ func (s *ServerConnection) IntrusionPreventionGet() (*IntrusionPreventionConfig,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) IntrusionPreventionSet(config IntrusionPreventionConfig) (ErrorList, error) {
+	return s.IntrusionPreventionSetContext(context.Background(), config)
+}
+
+// IntrusionPreventionSetContext - IntrusionPreventionSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionSetContext(ctx context.Context, config IntrusionPreventionConfig) (ErrorList, error) {
 	params := struct {
 		Config IntrusionPreventionConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("IntrusionPrevention.set", params)
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +106,15 @@ func (s *ServerConnection) IntrusionPreventionSet(config IntrusionPreventionConf
 
 // IntrusionPreventionGetSignatureDescription - Returns signature description
 func (s *ServerConnection) IntrusionPreventionGetSignatureDescription(id string) (string, error) {
+	return s.IntrusionPreventionGetSignatureDescriptionContext(context.Background(), id)
+}
+
+// IntrusionPreventionGetSignatureDescriptionContext - IntrusionPreventionGetSignatureDescription with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionGetSignatureDescriptionContext(ctx context.Context, id string) (string, error) {
 	params := struct {
 		Id string `json:"id"`
 	}{id}
-	data, err := s.CallRaw("IntrusionPrevention.getSignatureDescription", params)
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.getSignatureDescription", params)
 	if err != nil {
 		return "", err
 	}
@@ -113,7 +131,12 @@ func (s *ServerConnection) IntrusionPreventionGetSignatureDescription(id string)
 // Return
 //	ignored - List of rules that are excluded from usage in IPS
 func (s *ServerConnection) IntrusionPreventionGetIgnoredRules() (RuleReferenceList, error) {
-	data, err := s.CallRaw("IntrusionPrevention.getIgnoredRules", nil)
+	return s.IntrusionPreventionGetIgnoredRulesContext(context.Background())
+}
+
+// IntrusionPreventionGetIgnoredRulesContext - IntrusionPreventionGetIgnoredRules with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionGetIgnoredRulesContext(ctx context.Context) (RuleReferenceList, error) {
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.getIgnoredRules", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -131,10 +154,15 @@ func (s *ServerConnection) IntrusionPreventionGetIgnoredRules() (RuleReferenceLi
 // Return
 //	errors - list of errors
 func (s *ServerConnection) IntrusionPreventionSetIgnoredRules(ignored RuleReferenceList) (ErrorList, error) {
+	return s.IntrusionPreventionSetIgnoredRulesContext(context.Background(), ignored)
+}
+
+// IntrusionPreventionSetIgnoredRulesContext - IntrusionPreventionSetIgnoredRules with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionSetIgnoredRulesContext(ctx context.Context, ignored RuleReferenceList) (ErrorList, error) {
 	params := struct {
 		Ignored RuleReferenceList `json:"ignored"`
 	}{ignored}
-	data, err := s.CallRaw("IntrusionPrevention.setIgnoredRules", params)
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.setIgnoredRules", params)
 	if err != nil {
 		return nil, err
 	}
@@ -150,10 +178,15 @@ func (s *ServerConnection) IntrusionPreventionSetIgnoredRules(ignored RuleRefere
 // IntrusionPreventionUpdate - Checks new version of database and updates it
 //  force - checks new version force if true
 func (s *ServerConnection) IntrusionPreventionUpdate(force bool) error {
+	return s.IntrusionPreventionUpdateContext(context.Background(), force)
+}
+
+// IntrusionPreventionUpdateContext - IntrusionPreventionUpdate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionUpdateContext(ctx context.Context, force bool) error {
 	params := struct {
 		Force bool `json:"force"`
 	}{force}
-	_, err := s.CallRaw("IntrusionPrevention.update", params)
+	_, err := s.CallRawContext(ctx, "IntrusionPrevention.update", params)
 	return err
 }
 
@@ -161,7 +194,12 @@ func (s *ServerConnection) IntrusionPreventionUpdate(force bool) error {
 // Return
 //  status - actual state of Update
 func (s *ServerConnection) IntrusionPreventionGetUpdateStatus() (*IntrusionPreventionInfo, error) {
-	data, err := s.CallRaw("IntrusionPrevention.getUpdateStatus", nil)
+	return s.IntrusionPreventionGetUpdateStatusContext(context.Background())
+}
+
+// IntrusionPreventionGetUpdateStatusContext - IntrusionPreventionGetUpdateStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IntrusionPreventionGetUpdateStatusContext(ctx context.Context) (*IntrusionPreventionInfo, error) {
+	data, err := s.CallRawContext(ctx, "IntrusionPrevention.getUpdateStatus", nil)
 	if err != nil {
 		return nil, err
 	}
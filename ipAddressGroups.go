@@ -1,12 +1,172 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
+
+type AddressGroupItemType string
+
+const (
+	AddressGroupHost        AddressGroupItemType = "Host"        // single host given by IP address or DNS name
+	AddressGroupNetwork     AddressGroupItemType = "Network"     // network given by address and mask
+	AddressGroupRange       AddressGroupItemType = "Range"       // range of IP addresses
+	AddressGroupChildGroup  AddressGroupItemType = "ChildGroup"  // another IP address group
+	AddressGroupThisMachine AddressGroupItemType = "ThisMachine" // the firewall itself
+)
+
+type IpAddressGroup struct {
+	Id   KId    `json:"id"`
+	Name string `json:"name"`
+}
+
+type IpAddressEntry struct {
+	Id          KId                  `json:"id"`
+	GroupId     KId                  `json:"groupId"`
+	SharedId    KId                  `json:"sharedId"` // read-only; filled when the item is shared in MyKerio
+	GroupName   string               `json:"groupName"`
+	Description string               `json:"description"`
+	Type        AddressGroupItemType `json:"type"`
+	Enabled     bool                 `json:"enabled"`
+	Status      StoreStatus          `json:"status"`
+	/*@{ host */
+	Host string `json:"host"` // IP address or DNS name
+	/*@}*/
+	/*@{ network, range */
+	Addr1 IpAddress `json:"addr1"` // network address or first address of the range
+	Addr2 IpAddress `json:"addr2"` // network mask or last address of the range
+	/*@}*/
+	/*@{ group */
+	ChildGroupId   KId    `json:"childGroupId"`
+	ChildGroupName string `json:"childGroupName"`
+	/*@}*/
+}
+
+type IpAddressEntryList []IpAddressEntry
+
+type IpAddressGroupList []IpAddressGroup
+
+// IpAddressGroupsGet - Get the list of IP groups
+//	query - conditions and limits
+// Return
+//	list - list of groups and it's details
+//	totalItems - count of all groups on server (before the start/limit applied)
+func (s *ServerConnection) IpAddressGroupsGet(query SearchQuery) (IpAddressEntryList, int, error) {
+	return s.IpAddressGroupsGetContext(context.Background(), query)
+}
+
+// IpAddressGroupsGetContext - IpAddressGroupsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsGetContext(ctx context.Context, query SearchQuery) (IpAddressEntryList, int, error) {
+	query = addMissedParametersToSearchQuery(query)
+	params := struct {
+		Query SearchQuery `json:"query"`
+	}{query}
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.get", params)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := struct {
+		Result struct {
+			List       IpAddressEntryList `json:"list"`
+			TotalItems int                `json:"totalItems"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &list)
+	return list.Result.List, list.Result.TotalItems, err
+}
+
+// IpAddressGroupsCreate - Add new groups
+//	groups - details for new groups. field id is assigned by the manager to temporary value until apply() or reset().
+// Return
+//	errors - list of errors
+//	result - list of IDs assigned to each item
+func (s *ServerConnection) IpAddressGroupsCreate(groups IpAddressEntryList) (ErrorList, CreateResultList, error) {
+	return s.IpAddressGroupsCreateContext(context.Background(), groups)
+}
+
+// IpAddressGroupsCreateContext - IpAddressGroupsCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsCreateContext(ctx context.Context, groups IpAddressEntryList) (ErrorList, CreateResultList, error) {
+	params := struct {
+		Groups IpAddressEntryList `json:"groups"`
+	}{groups}
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.create", params)
+	if err != nil {
+		return nil, nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList        `json:"errors"`
+			Result CreateResultList `json:"result"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, errors.Result.Result, err
+}
+
+// IpAddressGroupsSet - Update existing groups
+//	groupIds - ids of groups to be updated.
+//	details - details for update. Field "kerio::web::KId" is ignored. All other fields must be filled and they are written to all groups specified by groupIds.
+// Return
+//	errors - list of errors
+func (s *ServerConnection) IpAddressGroupsSet(groupIds StringList, details IpAddressEntry) (ErrorList, error) {
+	return s.IpAddressGroupsSetContext(context.Background(), groupIds, details)
+}
+
+// IpAddressGroupsSetContext - IpAddressGroupsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsSetContext(ctx context.Context, groupIds StringList, details IpAddressEntry) (ErrorList, error) {
+	params := struct {
+		GroupIds StringList     `json:"groupIds"`
+		Details  IpAddressEntry `json:"details"`
+	}{groupIds, details}
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.set", params)
+	if err != nil {
+		return nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList `json:"errors"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, err
+}
+
+// IpAddressGroupsRemove - Remove groups
+//	groupIds - ids of groups that should be removed
+// Return
+//	errors - list of errors
+func (s *ServerConnection) IpAddressGroupsRemove(groupIds StringList) (ErrorList, error) {
+	return s.IpAddressGroupsRemoveContext(context.Background(), groupIds)
+}
+
+// IpAddressGroupsRemoveContext - IpAddressGroupsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsRemoveContext(ctx context.Context, groupIds StringList) (ErrorList, error) {
+	params := struct {
+		GroupIds StringList `json:"groupIds"`
+	}{groupIds}
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.remove", params)
+	if err != nil {
+		return nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList `json:"errors"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, err
+}
 
 // IpAddressGroupsApply - Write changes cached in manager to configuration
 // Return
 //	errors - list of errors
 func (s *ServerConnection) IpAddressGroupsApply() (ErrorList, error) {
-	data, err := s.CallRaw("IpAddressGroups.apply", nil)
+	return s.IpAddressGroupsApplyContext(context.Background())
+}
+
+// IpAddressGroupsApplyContext - IpAddressGroupsApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +181,31 @@ func (s *ServerConnection) IpAddressGroupsApply() (ErrorList, error) {
 
 // IpAddressGroupsReset - Discard changes cached in manager
 func (s *ServerConnection) IpAddressGroupsReset() error {
-	_, err := s.CallRaw("IpAddressGroups.reset", nil)
+	return s.IpAddressGroupsResetContext(context.Background())
+}
+
+// IpAddressGroupsResetContext - IpAddressGroupsReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "IpAddressGroups.reset", nil)
 	return err
 }
+
+// IpAddressGroupsGetGroupList - Get the list of groups, sorted in asc order
+func (s *ServerConnection) IpAddressGroupsGetGroupList() (IpAddressGroupList, error) {
+	return s.IpAddressGroupsGetGroupListContext(context.Background())
+}
+
+// IpAddressGroupsGetGroupListContext - IpAddressGroupsGetGroupList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpAddressGroupsGetGroupListContext(ctx context.Context) (IpAddressGroupList, error) {
+	data, err := s.CallRawContext(ctx, "IpAddressGroups.getGroupList", nil)
+	if err != nil {
+		return nil, err
+	}
+	groups := struct {
+		Result struct {
+			Groups IpAddressGroupList `json:"groups"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &groups)
+	return groups.Result.Groups, err
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // IpServiceReference - service reference used in various policies
 type IpServiceReference struct {
@@ -82,11 +85,16 @@ type IpServiceList []IpService
 //	list - list of services and it's details
 //	totalItems - count of all services on server (before the start/limit applied)
 func (s *ServerConnection) IpServicesGet(query SearchQuery) (IpServiceList, int, error) {
+	return s.IpServicesGetContext(context.Background(), query)
+}
+
+// IpServicesGetContext - IpServicesGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesGetContext(ctx context.Context, query SearchQuery) (IpServiceList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("IpServices.get", params)
+	data, err := s.CallRawContext(ctx, "IpServices.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -106,10 +114,15 @@ func (s *ServerConnection) IpServicesGet(query SearchQuery) (IpServiceList, int,
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) IpServicesCreate(services IpServiceList) (ErrorList, CreateResultList, error) {
+	return s.IpServicesCreateContext(context.Background(), services)
+}
+
+// IpServicesCreateContext - IpServicesCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesCreateContext(ctx context.Context, services IpServiceList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Services IpServiceList `json:"services"`
 	}{services}
-	data, err := s.CallRaw("IpServices.create", params)
+	data, err := s.CallRawContext(ctx, "IpServices.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,11 +142,16 @@ func (s *ServerConnection) IpServicesCreate(services IpServiceList) (ErrorList,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) IpServicesSet(serviceIds StringList, details IpService) (ErrorList, error) {
+	return s.IpServicesSetContext(context.Background(), serviceIds, details)
+}
+
+// IpServicesSetContext - IpServicesSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesSetContext(ctx context.Context, serviceIds StringList, details IpService) (ErrorList, error) {
 	params := struct {
 		ServiceIds StringList `json:"serviceIds"`
 		Details    IpService  `json:"details"`
 	}{serviceIds, details}
-	data, err := s.CallRaw("IpServices.set", params)
+	data, err := s.CallRawContext(ctx, "IpServices.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -151,10 +169,15 @@ func (s *ServerConnection) IpServicesSet(serviceIds StringList, details IpServic
 // Return
 //	errors - list of errors TODO write particular values
 func (s *ServerConnection) IpServicesRemove(serviceIds StringList) (ErrorList, error) {
+	return s.IpServicesRemoveContext(context.Background(), serviceIds)
+}
+
+// IpServicesRemoveContext - IpServicesRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesRemoveContext(ctx context.Context, serviceIds StringList) (ErrorList, error) {
 	params := struct {
 		ServiceIds StringList `json:"serviceIds"`
 	}{serviceIds}
-	data, err := s.CallRaw("IpServices.remove", params)
+	data, err := s.CallRawContext(ctx, "IpServices.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +194,12 @@ func (s *ServerConnection) IpServicesRemove(serviceIds StringList) (ErrorList, e
 // Return
 //	errors - list of errors
 func (s *ServerConnection) IpServicesApply() (ErrorList, error) {
-	data, err := s.CallRaw("IpServices.apply", nil)
+	return s.IpServicesApplyContext(context.Background())
+}
+
+// IpServicesApplyContext - IpServicesApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "IpServices.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +214,11 @@ func (s *ServerConnection) IpServicesApply() (ErrorList, error) {
 
 // IpServicesReset - Discard changes cached in manager
 func (s *ServerConnection) IpServicesReset() error {
-	_, err := s.CallRaw("IpServices.reset", nil)
+	return s.IpServicesResetContext(context.Background())
+}
+
+// IpServicesResetContext - IpServicesReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpServicesResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "IpServices.reset", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ActiveTool string
 
@@ -45,7 +48,12 @@ const (
 
 // IpToolsGetStatus - Get status currently running tool
 func (s *ServerConnection) IpToolsGetStatus() (*ActiveTool, StringList, error) {
-	data, err := s.CallRaw("IpTools.getStatus", nil)
+	return s.IpToolsGetStatusContext(context.Background())
+}
+
+// IpToolsGetStatusContext - IpToolsGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsGetStatusContext(ctx context.Context) (*ActiveTool, StringList, error) {
+	data, err := s.CallRawContext(ctx, "IpTools.getStatus", nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,12 +69,22 @@ func (s *ServerConnection) IpToolsGetStatus() (*ActiveTool, StringList, error) {
 
 // IpToolsStop - Interrupt currently running tool
 func (s *ServerConnection) IpToolsStop() error {
-	_, err := s.CallRaw("IpTools.stop", nil)
+	return s.IpToolsStopContext(context.Background())
+}
+
+// IpToolsStopContext - IpToolsStop with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsStopContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "IpTools.stop", nil)
 	return err
 }
 
 // IpToolsPing - Ping
 func (s *ServerConnection) IpToolsPing(target string, ipv IpVersion, infinite bool, packetSize int, allowFragmentation bool) error {
+	return s.IpToolsPingContext(context.Background(), target, ipv, infinite, packetSize, allowFragmentation)
+}
+
+// IpToolsPingContext - IpToolsPing with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsPingContext(ctx context.Context, target string, ipv IpVersion, infinite bool, packetSize int, allowFragmentation bool) error {
 	params := struct {
 		Target             string    `json:"target"`
 		Ipv                IpVersion `json:"ipv"`
@@ -74,45 +92,65 @@ func (s *ServerConnection) IpToolsPing(target string, ipv IpVersion, infinite bo
 		PacketSize         int       `json:"packetSize"`
 		AllowFragmentation bool      `json:"allowFragmentation"`
 	}{target, ipv, infinite, packetSize, allowFragmentation}
-	_, err := s.CallRaw("IpTools.ping", params)
+	_, err := s.CallRawContext(ctx, "IpTools.ping", params)
 	return err
 }
 
 // IpToolsTraceRoute - TraceRoute
 func (s *ServerConnection) IpToolsTraceRoute(target string, ipv IpVersion, resolveHostnames bool) error {
+	return s.IpToolsTraceRouteContext(context.Background(), target, ipv, resolveHostnames)
+}
+
+// IpToolsTraceRouteContext - IpToolsTraceRoute with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsTraceRouteContext(ctx context.Context, target string, ipv IpVersion, resolveHostnames bool) error {
 	params := struct {
 		Target           string    `json:"target"`
 		Ipv              IpVersion `json:"ipv"`
 		ResolveHostnames bool      `json:"resolveHostnames"`
 	}{target, ipv, resolveHostnames}
-	_, err := s.CallRaw("IpTools.traceRoute", params)
+	_, err := s.CallRawContext(ctx, "IpTools.traceRoute", params)
 	return err
 }
 
 // IpToolsWhois - Whois
 func (s *ServerConnection) IpToolsWhois(target string) error {
+	return s.IpToolsWhoisContext(context.Background(), target)
+}
+
+// IpToolsWhoisContext - IpToolsWhois with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsWhoisContext(ctx context.Context, target string) error {
 	params := struct {
 		Target string `json:"target"`
 	}{target}
-	_, err := s.CallRaw("IpTools.whois", params)
+	_, err := s.CallRawContext(ctx, "IpTools.whois", params)
 	return err
 }
 
 // IpToolsDns - 1004 Access denied  - "Insufficient rights to perform the requested operation."
 func (s *ServerConnection) IpToolsDns(name string, server string, tool DnsTool, dnsType DnsType) error {
+	return s.IpToolsDnsContext(context.Background(), name, server, tool, dnsType)
+}
+
+// IpToolsDnsContext - IpToolsDns with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsDnsContext(ctx context.Context, name string, server string, tool DnsTool, dnsType DnsType) error {
 	params := struct {
 		Name    string  `json:"name"`
 		Server  string  `json:"server"`
 		Tool    DnsTool `json:"tool"`
 		DnsType DnsType `json:"dnsType"`
 	}{name, server, tool, dnsType}
-	_, err := s.CallRaw("IpTools.dns", params)
+	_, err := s.CallRawContext(ctx, "IpTools.dns", params)
 	return err
 }
 
 // IpToolsGetDnsServers - 1004 Access denied  - "Insufficient rights to perform the requested operation."
 func (s *ServerConnection) IpToolsGetDnsServers() (StringList, error) {
-	data, err := s.CallRaw("IpTools.getDnsServers", nil)
+	return s.IpToolsGetDnsServersContext(context.Background())
+}
+
+// IpToolsGetDnsServersContext - IpToolsGetDnsServers with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) IpToolsGetDnsServersContext(ctx context.Context) (StringList, error) {
+	data, err := s.CallRawContext(ctx, "IpTools.getDnsServers", nil)
 	if err != nil {
 		return nil, err
 	}
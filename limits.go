@@ -0,0 +1,175 @@
+package control
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Limits - limits of requests sent to one server. Waiting callers are served in order of arrival
+// and the waiting respects their contexts.
+type Limits struct {
+	RequestsPerSecond float64 // maximal rate of requests, unlimited if zero
+	Burst             int     // count of requests which may be sent at once when the rate was not reached recently, 1 if zero
+	MaxInFlight       int     // maximal count of concurrent requests, unlimited if zero
+	// Methods - limits of particular methods (e.g. "Logs.get"), applied in addition to the limits of the server
+	Methods map[string]Limits
+}
+
+// SetLimits sets limits of requests of all connections created by the configuration.
+// It must be called before the connections are used.
+func (c *Config) SetLimits(limits Limits) {
+	c.limiter = newLimiter(limits)
+	c.limiter.methods = make(map[string]*limiter, len(limits.Methods))
+	for method, l := range limits.Methods {
+		c.limiter.methods[method] = newLimiter(l)
+	}
+}
+
+type limiter struct {
+	rate    *rateLimiter
+	slots   *semaphore
+	methods map[string]*limiter
+}
+
+func newLimiter(limits Limits) *limiter {
+	l := &limiter{}
+	if limits.RequestsPerSecond > 0 {
+		burst := limits.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		interval := time.Duration(float64(time.Second) / limits.RequestsPerSecond)
+		l.rate = &rateLimiter{
+			interval:  interval,
+			tolerance: time.Duration(burst-1) * interval,
+		}
+	}
+	if limits.MaxInFlight > 0 {
+		l.slots = &semaphore{size: limits.MaxInFlight}
+	}
+	return l
+}
+
+// acquire waits until a request of method may be sent, the returned function must be called when it is done.
+// Empty method stands for requests not bound to a method (batches, uploads, downloads).
+func (c *Config) acquire(ctx context.Context, method string) (func(), error) {
+	if c.limiter == nil {
+		return func() {}, nil
+	}
+	// limits of the method are waited for first, so that a call waiting for its method (e.g. the second
+	// long polling Notifications.get) does not hold the limits of the server needed by other methods
+	limiters := []*limiter{c.limiter}
+	if l, ok := c.limiter.methods[method]; ok {
+		limiters = []*limiter{l, c.limiter}
+	}
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+	for _, l := range limiters {
+		if l.rate != nil {
+			if err := l.rate.wait(ctx); err != nil {
+				release()
+				return nil, err
+			}
+		}
+		if l.slots != nil {
+			if err := l.slots.acquire(ctx); err != nil {
+				release()
+				return nil, err
+			}
+			releases = append(releases, l.slots.release)
+		}
+	}
+	return release, nil
+}
+
+// rateLimiter - limiter of rate by generic cell rate algorithm, reservations are made in order of arrival
+type rateLimiter struct {
+	mu        sync.Mutex
+	interval  time.Duration // interval between requests at the maximal rate
+	tolerance time.Duration // how much earlier may a request be sent thanks to the burst
+	tat       time.Time     // theoretical arrival time of the next request
+}
+
+// wait reserves the next request and waits until it may be sent. The reservation is cancelled with ctx
+// if no later request was reserved meanwhile, later requests have been scheduled after it already.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	r.mu.Lock()
+	now := time.Now()
+	tat := r.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	delay := tat.Sub(now) - r.tolerance
+	reserved := tat.Add(r.interval)
+	r.tat = reserved
+	r.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.mu.Lock()
+		if r.tat.Equal(reserved) {
+			r.tat = tat
+		}
+		r.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// semaphore - counting semaphore granting slots in order of arrival
+type semaphore struct {
+	mu      sync.Mutex
+	size    int
+	used    int
+	waiters list.List // of chan struct{}, closed when the slot is handed over
+}
+
+func (s *semaphore) acquire(ctx context.Context) error {
+	s.mu.Lock()
+	if s.used < s.size && s.waiters.Len() == 0 {
+		s.used++
+		s.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	elem := s.waiters.PushBack(ready)
+	s.mu.Unlock()
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		select {
+		case <-ready:
+			// the slot was handed over meanwhile, pass it to the next one
+			s.mu.Unlock()
+			s.release()
+		default:
+			s.waiters.Remove(elem)
+			s.mu.Unlock()
+		}
+		return ctx.Err()
+	}
+}
+
+func (s *semaphore) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if front := s.waiters.Front(); front != nil {
+		s.waiters.Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+	s.used--
+}
@@ -0,0 +1,82 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimitsMethodWaitKeepsServerSlots(t *testing.T) {
+	config := NewConfig("server_addr")
+	config.SetLimits(Limits{
+		MaxInFlight: 2,
+		Methods:     map[string]Limits{"Notifications.get": {MaxInFlight: 1}},
+	})
+	release, err := config.acquire(context.Background(), "Notifications.get")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	// the second long poll waits for the slot of its method
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	waiting := make(chan error, 1)
+	go func() {
+		release, err := config.acquire(ctx, "Notifications.get")
+		if err == nil {
+			release()
+		}
+		waiting <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	// other methods get the remaining slot of the server
+	short, cancelShort := context.WithTimeout(context.Background(), time.Second)
+	defer cancelShort()
+	other, err := config.acquire(short, "Users.get")
+	if err != nil {
+		t.Fatalf("got %v, expected a slot for another method", err)
+	}
+	other()
+	cancel()
+	if err = <-waiting; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, expected the second long poll to wait", err)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	r := &rateLimiter{interval: time.Hour}
+	if err := r.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	reserved := r.tat
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := r.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, expected deadline exceeded", err)
+	}
+	if !r.tat.Equal(reserved) {
+		t.Errorf("got reservations up to %s after cancel, expected %s", r.tat, reserved)
+	}
+
+	// the cancelled reservation is kept if a later one was made meanwhile
+	ctx, cancel = context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- r.wait(ctx)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	later, cancelLater := context.WithCancel(context.Background())
+	laterDone := make(chan error, 1)
+	go func() {
+		laterDone <- r.wait(later)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+	if expected := reserved.Add(2 * r.interval); !r.tat.Equal(expected) {
+		t.Errorf("got reservations up to %s, expected %s", r.tat, expected)
+	}
+	cancelLater()
+	<-laterDone
+}
@@ -0,0 +1,109 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// LogLevel - verbosity of logging of calls, see SetLogger
+type LogLevel int
+
+const (
+	LogInfo  LogLevel = iota // method, request id, duration and error of calls
+	LogDebug                 // as LogInfo, plus params and results of calls
+)
+
+// CallLog - record of one JSON-RPC call passed to Logger
+type CallLog struct {
+	Method   string
+	ID       int           // request id
+	Duration time.Duration // duration of the HTTP request, of the whole batch for batched calls
+	Err      error
+	Params   json.RawMessage // params with secrets redacted, only at LogDebug
+	Result   json.RawMessage // response with secrets redacted, only at LogDebug and when the server responded
+}
+
+// Logger receives records of calls sent by a connection
+type Logger interface {
+	LogCall(entry CallLog)
+}
+
+// LoggerFunc - function implementing Logger
+type LoggerFunc func(entry CallLog)
+
+// LogCall calls f(entry)
+func (f LoggerFunc) LogCall(entry CallLog) {
+	f(entry)
+}
+
+// NewStdLogger returns Logger writing records to l as lines of key=value pairs, the standard logger is used if l is nil
+func NewStdLogger(l *log.Logger) Logger {
+	if l == nil {
+		l = log.Default()
+	}
+	return LoggerFunc(func(entry CallLog) {
+		var b strings.Builder
+		fmt.Fprintf(&b, "method=%s id=%d duration=%s", entry.Method, entry.ID, entry.Duration)
+		if entry.Err != nil {
+			fmt.Fprintf(&b, " error=%q", entry.Err.Error())
+		}
+		if entry.Params != nil {
+			fmt.Fprintf(&b, " params=%s", entry.Params)
+		}
+		if entry.Result != nil {
+			fmt.Fprintf(&b, " result=%s", entry.Result)
+		}
+		l.Print(b.String())
+	})
+}
+
+type callLogger struct {
+	logger Logger
+	level  LogLevel
+}
+
+// SetLogger enables logging of calls of the connection, including batched ones, to logger.
+// Passwords, session tokens and other secrets are redacted from params and results logged at LogDebug,
+// so the logs are safe to be shipped elsewhere. Passing nil logger disables logging.
+// SetLogger must not be called concurrently with calls.
+//	logger - receiver of the records, see NewStdLogger
+//	level - verbosity of the records
+func (s *ServerConnection) SetLogger(logger Logger, level LogLevel) {
+	if logger == nil {
+		s.logger = nil
+		return
+	}
+	s.logger = &callLogger{
+		logger: logger,
+		level:  level,
+	}
+}
+
+// log passes record of the call to the logger of the connection, if any
+//	params - params of the call, either a value to be encoded or the encoded json.RawMessage
+//	data - raw response, nil if there is none
+func (s *ServerConnection) log(method string, id int, start time.Time, params interface{}, data []byte, err error) {
+	if s.logger == nil {
+		return
+	}
+	entry := CallLog{
+		Method:   method,
+		ID:       id,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if s.logger.level >= LogDebug {
+		if params != nil {
+			if buffer, e := json.Marshal(params); e == nil {
+				entry.Params = redactParams(method, buffer)
+			}
+		}
+		if data != nil {
+			entry.Result = redactJSON(data)
+		}
+	}
+	s.logger.logger.LogCall(entry)
+}
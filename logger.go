@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type StatusFunction struct {
 	Id   KId    `json:"id"`
@@ -19,11 +22,16 @@ const (
 // LoggerLogWrite - Write a message to given log
 //	message - text to be written into log file
 func (s *ServerConnection) LoggerLogWrite(logType LogType, message string) error {
+	return s.LoggerLogWriteContext(context.Background(), logType, message)
+}
+
+// LoggerLogWriteContext - LoggerLogWrite with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerLogWriteContext(ctx context.Context, logType LogType, message string) error {
 	params := struct {
 		LogType LogType `json:"logType"`
 		Message string  `json:"message"`
 	}{logType, message}
-	_, err := s.CallRaw("Logger.logWrite", params)
+	_, err := s.CallRawContext(ctx, "Logger.logWrite", params)
 	return err
 }
 
@@ -31,7 +39,12 @@ func (s *ServerConnection) LoggerLogWrite(logType LogType, message string) error
 // Return
 //  functions - list of Functions displayed in debug log context menu 'Show status'
 func (s *ServerConnection) LoggerGetStatusFunctionList() (StatusFunctionList, error) {
-	data, err := s.CallRaw("Logger.getStatusFunctionList", nil)
+	return s.LoggerGetStatusFunctionListContext(context.Background())
+}
+
+// LoggerGetStatusFunctionListContext - LoggerGetStatusFunctionList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerGetStatusFunctionListContext(ctx context.Context) (StatusFunctionList, error) {
+	data, err := s.CallRawContext(ctx, "Logger.getStatusFunctionList", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +60,15 @@ func (s *ServerConnection) LoggerGetStatusFunctionList() (StatusFunctionList, er
 // LoggerCallStatusFunction - Calls function from StatusFunctionList referenced by id
 //	id - ID function
 func (s *ServerConnection) LoggerCallStatusFunction(id KId) error {
+	return s.LoggerCallStatusFunctionContext(context.Background(), id)
+}
+
+// LoggerCallStatusFunctionContext - LoggerCallStatusFunction with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerCallStatusFunctionContext(ctx context.Context, id KId) error {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	_, err := s.CallRaw("Logger.callStatusFunction", params)
+	_, err := s.CallRawContext(ctx, "Logger.callStatusFunction", params)
 	return err
 }
 
@@ -58,7 +76,12 @@ func (s *ServerConnection) LoggerCallStatusFunction(id KId) error {
 // Return
 //  logType - actual Http log type
 func (s *ServerConnection) LoggerGetHttpLogType() (*HttpLogType, error) {
-	data, err := s.CallRaw("Logger.getHttpLogType", nil)
+	return s.LoggerGetHttpLogTypeContext(context.Background())
+}
+
+// LoggerGetHttpLogTypeContext - LoggerGetHttpLogType with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerGetHttpLogTypeContext(ctx context.Context) (*HttpLogType, error) {
+	data, err := s.CallRawContext(ctx, "Logger.getHttpLogType", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -74,10 +97,15 @@ func (s *ServerConnection) LoggerGetHttpLogType() (*HttpLogType, error) {
 // LoggerSetHttpLogType - Stores Http log type
 //  ogType - Http log type
 func (s *ServerConnection) LoggerSetHttpLogType(logType HttpLogType) error {
+	return s.LoggerSetHttpLogTypeContext(context.Background(), logType)
+}
+
+// LoggerSetHttpLogTypeContext - LoggerSetHttpLogType with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerSetHttpLogTypeContext(ctx context.Context, logType HttpLogType) error {
 	params := struct {
 		LogType HttpLogType `json:"logType"`
 	}{logType}
-	_, err := s.CallRaw("Logger.setHttpLogType", params)
+	_, err := s.CallRawContext(ctx, "Logger.setHttpLogType", params)
 	return err
 }
 
@@ -85,7 +113,12 @@ func (s *ServerConnection) LoggerSetHttpLogType(logType HttpLogType) error {
 // Return
 //  expression - expression for dialog from debug log context menu 'IP Traffic...'
 func (s *ServerConnection) LoggerGetLogExpression() (string, error) {
-	data, err := s.CallRaw("Logger.getLogExpression", nil)
+	return s.LoggerGetLogExpressionContext(context.Background())
+}
+
+// LoggerGetLogExpressionContext - LoggerGetLogExpression with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerGetLogExpressionContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "Logger.getLogExpression", nil)
 	if err != nil {
 		return "", err
 	}
@@ -101,10 +134,15 @@ func (s *ServerConnection) LoggerGetLogExpression() (string, error) {
 // LoggerSetLogExpression - Stores expression from debug log context menu dialog 'IP Traffic...'
 //  expression - expression for dialog from debug log context menu 'IP Traffic...'
 func (s *ServerConnection) LoggerSetLogExpression(expression string) error {
+	return s.LoggerSetLogExpressionContext(context.Background(), expression)
+}
+
+// LoggerSetLogExpressionContext - LoggerSetLogExpression with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerSetLogExpressionContext(ctx context.Context, expression string) error {
 	params := struct {
 		Expression string `json:"expression"`
 	}{expression}
-	_, err := s.CallRaw("Logger.setLogExpression", params)
+	_, err := s.CallRawContext(ctx, "Logger.setLogExpression", params)
 	return err
 }
 
@@ -112,7 +150,12 @@ func (s *ServerConnection) LoggerSetLogExpression(expression string) error {
 // Return
 //  format - format for dialog from debug log context menu 'Packet Log format...'
 func (s *ServerConnection) LoggerGetPacketLogFormat() (string, error) {
-	data, err := s.CallRaw("Logger.getPacketLogFormat", nil)
+	return s.LoggerGetPacketLogFormatContext(context.Background())
+}
+
+// LoggerGetPacketLogFormatContext - LoggerGetPacketLogFormat with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerGetPacketLogFormatContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "Logger.getPacketLogFormat", nil)
 	if err != nil {
 		return "", err
 	}
@@ -128,9 +171,14 @@ func (s *ServerConnection) LoggerGetPacketLogFormat() (string, error) {
 // LoggerSetPacketLogFormat - Stores format from debug log context menu dialog 'Packet Log format...'
 //  format - format for dialog from debug log context menu 'Packet Log format...'
 func (s *ServerConnection) LoggerSetPacketLogFormat(format string) error {
+	return s.LoggerSetPacketLogFormatContext(context.Background(), format)
+}
+
+// LoggerSetPacketLogFormatContext - LoggerSetPacketLogFormat with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoggerSetPacketLogFormatContext(ctx context.Context, format string) error {
 	params := struct {
 		Format string `json:"format"`
 	}{format}
-	_, err := s.CallRaw("Logger.setPacketLogFormat", params)
+	_, err := s.CallRawContext(ctx, "Logger.setPacketLogFormat", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // Global limits:
 // 1. maximum of returned lines at once is 50000
@@ -162,20 +165,30 @@ const (
 // LogsCancelSearch - Cancel search on server (useful for large logs).
 //	searchId - identifier from search()
 func (s *ServerConnection) LogsCancelSearch(searchId string) error {
+	return s.LogsCancelSearchContext(context.Background(), searchId)
+}
+
+// LogsCancelSearchContext - LogsCancelSearch with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsCancelSearchContext(ctx context.Context, searchId string) error {
 	params := struct {
 		SearchId string `json:"searchId"`
 	}{searchId}
-	_, err := s.CallRaw("Logs.cancelSearch", params)
+	_, err := s.CallRawContext(ctx, "Logs.cancelSearch", params)
 	return err
 }
 
 // LogsClear - Delete all log lines.
 //	logName - unique name of the log
 func (s *ServerConnection) LogsClear(logName LogType) error {
+	return s.LogsClearContext(context.Background(), logName)
+}
+
+// LogsClearContext - LogsClear with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsClearContext(ctx context.Context, logName LogType) error {
 	params := struct {
 		LogName LogType `json:"logName"`
 	}{logName}
-	_, err := s.CallRaw("Logs.clear", params)
+	_, err := s.CallRawContext(ctx, "Logs.clear", params)
 	return err
 }
 
@@ -186,13 +199,18 @@ func (s *ServerConnection) LogsClear(logName LogType) error {
 // Return
 //	fileDownload - file download structure
 func (s *ServerConnection) LogsExportLog(logName LogType, fromLine int, countLines int, exportFormat ExportFormat) (*Download, error) {
+	return s.LogsExportLogContext(context.Background(), logName, fromLine, countLines, exportFormat)
+}
+
+// LogsExportLogContext - LogsExportLog with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsExportLogContext(ctx context.Context, logName LogType, fromLine int, countLines int, exportFormat ExportFormat) (*Download, error) {
 	params := struct {
 		LogName      LogType      `json:"logName"`
 		FromLine     int          `json:"fromLine"`
 		CountLines   int          `json:"countLines"`
 		ExportFormat ExportFormat `json:"exportFormat"`
 	}{logName, fromLine, countLines, exportFormat}
-	data, err := s.CallRaw("Logs.exportLog", params)
+	data, err := s.CallRawContext(ctx, "Logs.exportLog", params)
 	if err != nil {
 		return nil, err
 	}
@@ -212,13 +230,18 @@ func (s *ServerConnection) LogsExportLog(logName LogType, fromLine int, countLin
 // Return
 //	fileDownload - file download structure
 func (s *ServerConnection) LogsExportLogRelative(logName LogType, fromLine int, countLines int, exportFormat ExportFormat) (*Download, error) {
+	return s.LogsExportLogRelativeContext(context.Background(), logName, fromLine, countLines, exportFormat)
+}
+
+// LogsExportLogRelativeContext - LogsExportLogRelative with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsExportLogRelativeContext(ctx context.Context, logName LogType, fromLine int, countLines int, exportFormat ExportFormat) (*Download, error) {
 	params := struct {
 		LogName      LogType      `json:"logName"`
 		FromLine     int          `json:"fromLine"`
 		CountLines   int          `json:"countLines"`
 		ExportFormat ExportFormat `json:"exportFormat"`
 	}{logName, fromLine, countLines, exportFormat}
-	data, err := s.CallRaw("Logs.exportLogRelative", params)
+	data, err := s.CallRawContext(ctx, "Logs.exportLogRelative", params)
 	if err != nil {
 		return nil, err
 	}
@@ -239,12 +262,17 @@ func (s *ServerConnection) LogsExportLogRelative(logName LogType, fromLine int,
 //	viewport - list of log lines; count of lines = min(count, NUMBER_OF_CURRENT LINES - from)
 //	totalItems - current count of all log lines
 func (s *ServerConnection) LogsGet(logName LogType, fromLine int, countLines int) (LogRowList, int, error) {
+	return s.LogsGetContext(context.Background(), logName, fromLine, countLines)
+}
+
+// LogsGetContext - LogsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetContext(ctx context.Context, logName LogType, fromLine int, countLines int) (LogRowList, int, error) {
 	params := struct {
 		LogName    LogType `json:"logName"`
 		FromLine   int     `json:"fromLine"`
 		CountLines int     `json:"countLines"`
 	}{logName, fromLine, countLines}
-	data, err := s.CallRaw("Logs.get", params)
+	data, err := s.CallRawContext(ctx, "Logs.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -262,7 +290,12 @@ func (s *ServerConnection) LogsGet(logName LogType, fromLine int, countLines int
 // Return
 //	rules - highlight rules
 func (s *ServerConnection) LogsGetHighlightRules() (*HighlightRules, error) {
-	data, err := s.CallRaw("Logs.getHighlightRules", nil)
+	return s.LogsGetHighlightRulesContext(context.Background())
+}
+
+// LogsGetHighlightRulesContext - LogsGetHighlightRules with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetHighlightRulesContext(ctx context.Context) (*HighlightRules, error) {
+	data, err := s.CallRawContext(ctx, "Logs.getHighlightRules", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +312,12 @@ func (s *ServerConnection) LogsGetHighlightRules() (*HighlightRules, error) {
 // Return
 //	logSet - list of valid logs
 func (s *ServerConnection) LogsGetLogSet() (*LogSet, error) {
-	data, err := s.CallRaw("Logs.getLogSet", nil)
+	return s.LogsGetLogSetContext(context.Background())
+}
+
+// LogsGetLogSetContext - LogsGetLogSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetLogSetContext(ctx context.Context) (*LogSet, error) {
+	data, err := s.CallRawContext(ctx, "Logs.getLogSet", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +334,12 @@ func (s *ServerConnection) LogsGetLogSet() (*LogSet, error) {
 // Return
 //	messages - tree of log messages
 func (s *ServerConnection) LogsGetMessages() (TreeLeafList, error) {
-	data, err := s.CallRaw("Logs.getMessages", nil)
+	return s.LogsGetMessagesContext(context.Background())
+}
+
+// LogsGetMessagesContext - LogsGetMessages with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetMessagesContext(ctx context.Context) (TreeLeafList, error) {
+	data, err := s.CallRawContext(ctx, "Logs.getMessages", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +354,12 @@ func (s *ServerConnection) LogsGetMessages() (TreeLeafList, error) {
 
 // LogsGetSearchProgress - Clears timeout for search() and obtains status of the search.
 func (s *ServerConnection) LogsGetSearchProgress() error {
-	_, err := s.CallRaw("Logs.getSearchProgress", nil)
+	return s.LogsGetSearchProgressContext(context.Background())
+}
+
+// LogsGetSearchProgressContext - LogsGetSearchProgress with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetSearchProgressContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Logs.getSearchProgress", nil)
 	return err
 }
 
@@ -320,10 +368,15 @@ func (s *ServerConnection) LogsGetSearchProgress() error {
 // Return
 //	currentSettings - current valid settings (or undefined data on failure)
 func (s *ServerConnection) LogsGetSettings(logName LogType) (*LogSettings, error) {
+	return s.LogsGetSettingsContext(context.Background(), logName)
+}
+
+// LogsGetSettingsContext - LogsGetSettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsGetSettingsContext(ctx context.Context, logName LogType) (*LogSettings, error) {
 	params := struct {
 		LogName LogType `json:"logName"`
 	}{logName}
-	data, err := s.CallRaw("Logs.getSettings", params)
+	data, err := s.CallRawContext(ctx, "Logs.getSettings", params)
 	if err != nil {
 		return nil, err
 	}
@@ -345,6 +398,11 @@ func (s *ServerConnection) LogsGetSettings(logName LogType) (*LogSettings, error
 // Return
 //	searchId - identifier that can be used for cancelSearch and getSearchProgress
 func (s *ServerConnection) LogsSearch(logName LogType, what string, fromLine int, toLine int, forward bool) (string, error) {
+	return s.LogsSearchContext(context.Background(), logName, what, fromLine, toLine, forward)
+}
+
+// LogsSearchContext - LogsSearch with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsSearchContext(ctx context.Context, logName LogType, what string, fromLine int, toLine int, forward bool) (string, error) {
 	params := struct {
 		LogName  LogType `json:"logName"`
 		What     string  `json:"what"`
@@ -352,7 +410,7 @@ func (s *ServerConnection) LogsSearch(logName LogType, what string, fromLine int
 		ToLine   int     `json:"toLine"`
 		Forward  bool    `json:"forward"`
 	}{logName, what, fromLine, toLine, forward}
-	data, err := s.CallRaw("Logs.search", params)
+	data, err := s.CallRawContext(ctx, "Logs.search", params)
 	if err != nil {
 		return "", err
 	}
@@ -368,20 +426,30 @@ func (s *ServerConnection) LogsSearch(logName LogType, what string, fromLine int
 // LogsSetHighlightRules - Set highlighting rules, rules have to be sorted purposely, the only way to change a rule is to change the whole ruleset.
 //	rules - highlight rules (ordered by priority)
 func (s *ServerConnection) LogsSetHighlightRules(rules HighlightRules) error {
+	return s.LogsSetHighlightRulesContext(context.Background(), rules)
+}
+
+// LogsSetHighlightRulesContext - LogsSetHighlightRules with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsSetHighlightRulesContext(ctx context.Context, rules HighlightRules) error {
 	params := struct {
 		Rules HighlightRules `json:"rules"`
 	}{rules}
-	_, err := s.CallRaw("Logs.setHighlightRules", params)
+	_, err := s.CallRawContext(ctx, "Logs.setHighlightRules", params)
 	return err
 }
 
 // LogsSetMessages - Change log message settings; makes sense only if LogItem.hasMessages == true.
 //	messages - tree of log messages
 func (s *ServerConnection) LogsSetMessages(messages TreeLeafList) error {
+	return s.LogsSetMessagesContext(context.Background(), messages)
+}
+
+// LogsSetMessagesContext - LogsSetMessages with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsSetMessagesContext(ctx context.Context, messages TreeLeafList) error {
 	params := struct {
 		Messages TreeLeafList `json:"messages"`
 	}{messages}
-	_, err := s.CallRaw("Logs.setMessages", params)
+	_, err := s.CallRawContext(ctx, "Logs.setMessages", params)
 	return err
 }
 
@@ -389,10 +457,15 @@ func (s *ServerConnection) LogsSetMessages(messages TreeLeafList) error {
 //	logName - unique name of the log
 //	newSettings
 func (s *ServerConnection) LogsSetSettings(logName LogType, newSettings LogSettings) error {
+	return s.LogsSetSettingsContext(context.Background(), logName, newSettings)
+}
+
+// LogsSetSettingsContext - LogsSetSettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogsSetSettingsContext(ctx context.Context, logName LogType, newSettings LogSettings) error {
 	params := struct {
 		LogName     LogType     `json:"logName"`
 		NewSettings LogSettings `json:"newSettings"`
 	}{logName, newSettings}
-	_, err := s.CallRaw("Logs.setSettings", params)
+	_, err := s.CallRawContext(ctx, "Logs.setSettings", params)
 	return err
 }
@@ -0,0 +1,226 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives measurements of calls sent by a connection, see SetMetrics
+type Metrics interface {
+	// ObserveCall is called for every call sent to the server, including batched ones, and for every transfer of a file
+	ObserveCall(call CallMetrics)
+	// ObserveRelogin is called when the connection logs in again because the session expired
+	ObserveRelogin(host string)
+}
+
+// CallMetrics - measurements of one call
+type CallMetrics struct {
+	Method       string        // method of the API, UploadMethod or DownloadMethod for transfers of files
+	Host         string        // host of the server with port
+	Duration     time.Duration // duration of the HTTP request, of the whole batch for batched calls
+	RequestSize  int           // size of the request in bytes
+	ResponseSize int           // size of the response in bytes
+	Err          error         // error of the call, *APIError if reported by the server
+}
+
+// SetMetrics enables measuring of calls of the connection by metrics, see PrometheusMetrics.
+// Passing nil metrics disables measuring. SetMetrics must not be called concurrently with calls.
+func (s *ServerConnection) SetMetrics(metrics Metrics) {
+	s.metrics = metrics
+}
+
+// observe passes measurements of the call to the metrics of the connection, if any
+func (s *ServerConnection) observe(method string, start time.Time, requestSize, responseSize int, err error) {
+	if s.metrics == nil {
+		return
+	}
+	s.metrics.ObserveCall(CallMetrics{
+		Method:       method,
+		Host:         s.Config.host(),
+		Duration:     time.Since(start),
+		RequestSize:  requestSize,
+		ResponseSize: responseSize,
+		Err:          err,
+	})
+}
+
+// DefaultBuckets - upper bounds of buckets of the latency histogram in seconds used by NewPrometheusMetrics
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics - Metrics kept in memory and written in Prometheus text format, safe for concurrent use.
+// One PrometheusMetrics may be shared by connections to many servers, they are distinguished by label host.
+type PrometheusMetrics struct {
+	mu       sync.Mutex
+	buckets  []float64
+	calls    map[callKey]*callStats
+	errors   map[errorKey]int64
+	relogins map[string]int64
+}
+
+type callKey struct {
+	host   string
+	method string
+}
+
+type errorKey struct {
+	callKey
+	code string
+}
+
+type callStats struct {
+	count         int64
+	requestBytes  int64
+	responseBytes int64
+	seconds       float64
+	buckets       []int64 // count of calls per bucket, not cumulative
+}
+
+// NewPrometheusMetrics returns empty PrometheusMetrics
+//	buckets - upper bounds of buckets of the latency histogram in seconds, DefaultBuckets if nil
+func NewPrometheusMetrics(buckets []float64) *PrometheusMetrics {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &PrometheusMetrics{
+		buckets:  buckets,
+		calls:    map[callKey]*callStats{},
+		errors:   map[errorKey]int64{},
+		relogins: map[string]int64{},
+	}
+}
+
+// ObserveCall counts the call
+func (m *PrometheusMetrics) ObserveCall(call CallMetrics) {
+	key := callKey{host: call.Host, method: call.Method}
+	seconds := call.Duration.Seconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats, ok := m.calls[key]
+	if !ok {
+		stats = &callStats{buckets: make([]int64, len(m.buckets))}
+		m.calls[key] = stats
+	}
+	stats.count++
+	stats.requestBytes += int64(call.RequestSize)
+	stats.responseBytes += int64(call.ResponseSize)
+	stats.seconds += seconds
+	if i := sort.SearchFloat64s(m.buckets, seconds); i < len(m.buckets) {
+		stats.buckets[i]++
+	}
+	if call.Err != nil {
+		// errors not reported by the server, e.g. network ones, have no code
+		code := "none"
+		var apiErr *APIError
+		if errors.As(call.Err, &apiErr) {
+			code = strconv.Itoa(apiErr.Code)
+		}
+		m.errors[errorKey{callKey: key, code: code}]++
+	}
+}
+
+// ObserveRelogin counts the re-login
+func (m *PrometheusMetrics) ObserveRelogin(host string) {
+	m.mu.Lock()
+	m.relogins[host]++
+	m.mu.Unlock()
+}
+
+// WriteTo writes the metrics to w in Prometheus text format
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	m.mu.Lock()
+	keys := make([]callKey, 0, len(m.calls))
+	for key := range m.calls {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+	errorKeys := make([]errorKey, 0, len(m.errors))
+	for key := range m.errors {
+		errorKeys = append(errorKeys, key)
+	}
+	sort.Slice(errorKeys, func(i, j int) bool {
+		if errorKeys[i].callKey != errorKeys[j].callKey {
+			return errorKeys[i].callKey.less(errorKeys[j].callKey)
+		}
+		return errorKeys[i].code < errorKeys[j].code
+	})
+	hosts := make([]string, 0, len(m.relogins))
+	for host := range m.relogins {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	b.WriteString("# HELP kerio_control_calls_total Count of calls of the API.\n")
+	b.WriteString("# TYPE kerio_control_calls_total counter\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "kerio_control_calls_total{%s} %d\n", key.labels(), m.calls[key].count)
+	}
+	b.WriteString("# HELP kerio_control_errors_total Count of failed calls of the API by error code.\n")
+	b.WriteString("# TYPE kerio_control_errors_total counter\n")
+	for _, key := range errorKeys {
+		fmt.Fprintf(&b, "kerio_control_errors_total{%s,code=\"%s\"} %d\n", key.labels(), key.code, m.errors[key])
+	}
+	b.WriteString("# HELP kerio_control_relogins_total Count of re-logins after expiration of the session.\n")
+	b.WriteString("# TYPE kerio_control_relogins_total counter\n")
+	for _, host := range hosts {
+		fmt.Fprintf(&b, "kerio_control_relogins_total{host=\"%s\"} %d\n", escapeLabel(host), m.relogins[host])
+	}
+	b.WriteString("# HELP kerio_control_request_bytes_total Size of requests sent to the API.\n")
+	b.WriteString("# TYPE kerio_control_request_bytes_total counter\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "kerio_control_request_bytes_total{%s} %d\n", key.labels(), m.calls[key].requestBytes)
+	}
+	b.WriteString("# HELP kerio_control_response_bytes_total Size of responses received from the API.\n")
+	b.WriteString("# TYPE kerio_control_response_bytes_total counter\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "kerio_control_response_bytes_total{%s} %d\n", key.labels(), m.calls[key].responseBytes)
+	}
+	b.WriteString("# HELP kerio_control_call_duration_seconds Latency of calls of the API.\n")
+	b.WriteString("# TYPE kerio_control_call_duration_seconds histogram\n")
+	for _, key := range keys {
+		stats := m.calls[key]
+		var cumulative int64
+		for i, bound := range m.buckets {
+			cumulative += stats.buckets[i]
+			fmt.Fprintf(&b, "kerio_control_call_duration_seconds_bucket{%s,le=\"%s\"} %d\n",
+				key.labels(), strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&b, "kerio_control_call_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", key.labels(), stats.count)
+		fmt.Fprintf(&b, "kerio_control_call_duration_seconds_sum{%s} %s\n", key.labels(), strconv.FormatFloat(stats.seconds, 'g', -1, 64))
+		fmt.Fprintf(&b, "kerio_control_call_duration_seconds_count{%s} %d\n", key.labels(), stats.count)
+	}
+	m.mu.Unlock()
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// ServeHTTP writes the metrics as the response, so PrometheusMetrics may be scraped directly
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = m.WriteTo(w)
+}
+
+func (k callKey) less(other callKey) bool {
+	if k.host != other.host {
+		return k.host < other.host
+	}
+	return k.method < other.method
+}
+
+func (k callKey) labels() string {
+	return fmt.Sprintf("host=\"%s\",method=\"%s\"", escapeLabel(k.host), escapeLabel(k.method))
+}
+
+// escapeLabel escapes value of a label for Prometheus text format
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
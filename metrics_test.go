@@ -0,0 +1,45 @@
+package control
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPrometheusMetricsWriteTo(t *testing.T) {
+	m := NewPrometheusMetrics([]float64{1, 0.1, 0.5})
+	host := "control.example.com:4081"
+	for _, call := range []CallMetrics{
+		{Method: "Users.get", Host: host, Duration: 250 * time.Millisecond, RequestSize: 100, ResponseSize: 2000},
+		{Method: "Users.get", Host: host, Duration: 500 * time.Millisecond, RequestSize: 100, ResponseSize: 3000},
+		{Method: "Users.get", Host: host, Duration: 2 * time.Second, RequestSize: 100, Err: ErrSessionExpired},
+		{Method: "Users.set", Host: host, Duration: 50 * time.Millisecond, RequestSize: 300, ResponseSize: 50,
+			Err: &APIError{Code: CodeInvalidParams, Message: "invalid params"}},
+		{Method: "Users.set", Host: host, Duration: 1 * time.Second, RequestSize: 300, Err: errors.New("connection reset")},
+		{Method: UploadMethod, Host: host, Duration: 125 * time.Millisecond, RequestSize: 4096, ResponseSize: 60},
+		{Method: "Odd\"method\\\n", Host: "other:4081", Duration: time.Millisecond, RequestSize: 1, ResponseSize: 1},
+	} {
+		m.ObserveCall(call)
+	}
+	m.ObserveRelogin(host)
+	m.ObserveRelogin(host)
+
+	got := &bytes.Buffer{}
+	n, err := m.WriteTo(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(got.Len()) {
+		t.Errorf("got %d bytes reported, %d written", n, got.Len())
+	}
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "metrics.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), expected) {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+}
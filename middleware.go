@@ -0,0 +1,33 @@
+package control
+
+import "context"
+
+// RoundTripper performs JSON-RPC call of method with params and returns the raw response
+type RoundTripper interface {
+	RoundTrip(ctx context.Context, method string, params interface{}) ([]byte, error)
+}
+
+// RoundTripperFunc - function implementing RoundTripper
+type RoundTripperFunc func(ctx context.Context, method string, params interface{}) ([]byte, error)
+
+// RoundTrip calls f(ctx, method, params)
+func (f RoundTripperFunc) RoundTrip(ctx context.Context, method string, params interface{}) ([]byte, error) {
+	return f(ctx, method, params)
+}
+
+// Middleware wraps RoundTripper by another one, e.g. for logging, metrics or fault injection
+type Middleware func(next RoundTripper) RoundTripper
+
+// Use appends middlewares to the chain every call of the connection passes through.
+// The first added middleware is the outermost one, the innermost one calls the server
+// (including automatic re-login, see SetRelogin). Use must not be called concurrently with calls.
+// Calls of a Batch pass through the middlewares concurrently and wait for each other at the innermost one,
+// so a middleware must not serialize them, e.g. by a mutex held over next.RoundTrip, see Batch.
+func (s *ServerConnection) Use(middlewares ...Middleware) {
+	s.middlewares = append(s.middlewares, middlewares...)
+	var rt RoundTripper = RoundTripperFunc(s.roundTrip)
+	for i := len(s.middlewares) - 1; i >= 0; i-- {
+		rt = s.middlewares[i](rt)
+	}
+	s.chain = rt
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type NotificationType string
 
@@ -57,11 +60,16 @@ type NotificationList []Notification
 // Return
 //	notifications - list of notifications
 func (s *ServerConnection) NotificationsGet(lastNotifications NotificationList, timeout int) (NotificationList, error) {
+	return s.NotificationsGetContext(context.Background(), lastNotifications, timeout)
+}
+
+// NotificationsGetContext - NotificationsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) NotificationsGetContext(ctx context.Context, lastNotifications NotificationList, timeout int) (NotificationList, error) {
 	params := struct {
 		LastNotifications NotificationList `json:"lastNotifications"`
 		Timeout           int              `json:"timeout"`
 	}{lastNotifications, timeout}
-	data, err := s.CallRaw("Notifications.get", params)
+	data, err := s.CallRawContext(ctx, "Notifications.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -77,9 +85,14 @@ func (s *ServerConnection) NotificationsGet(lastNotifications NotificationList,
 // NotificationsClear - Clears defined notification for current user
 //	notification - one of the notifications returned by get
 func (s *ServerConnection) NotificationsClear(notification Notification) error {
+	return s.NotificationsClearContext(context.Background(), notification)
+}
+
+// NotificationsClearContext - NotificationsClear with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) NotificationsClearContext(ctx context.Context, notification Notification) error {
 	params := struct {
 		Notification Notification `json:"notification"`
 	}{notification}
-	_, err := s.CallRaw("Notifications.clear", params)
+	_, err := s.CallRawContext(ctx, "Notifications.clear", params)
 	return err
 }
@@ -0,0 +1,23 @@
+module github.com/igiant/control/otelcontrol
+
+go 1.23.0
+
+require (
+	github.com/igiant/control v0.0.0-20261018095822-4e21914ca23b
+	go.opentelemetry.io/otel v1.38.0
+	go.opentelemetry.io/otel/sdk v1.38.0
+	go.opentelemetry.io/otel/trace v1.38.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.38.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+)
+
+// the root module of this repository is used while developing both together,
+// dependents of otelcontrol get the version required above
+replace github.com/igiant/control => ../
@@ -0,0 +1,86 @@
+// Package otelcontrol traces calls of Kerio Control API by OpenTelemetry.
+//
+// Every call becomes a client span named after the JSON-RPC method, e.g. "Users.get":
+//
+//	connection.SetTracer(otelcontrol.NewTracer(tracerProvider))
+package otelcontrol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/igiant/control"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName - name of the tracer obtained from the provider
+const instrumentationName = "github.com/igiant/control/otelcontrol"
+
+// Attributes of the spans besides the semantic conventions of RPC
+const (
+	RequestIDKey    = attribute.Key("kerio.request_id")    // request id of the JSON-RPC request
+	ResponseSizeKey = attribute.Key("kerio.response_size") // size of the raw response in bytes
+	ErrorCodeKey    = attribute.Key("kerio.error_code")    // code of ErrorReport returned by the server
+)
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns control.Tracer creating spans by provider, the global provider is used if provider is nil
+func NewTracer(provider trace.TracerProvider) control.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &tracer{tracer: provider.Tracer(instrumentationName)}
+}
+
+// Start starts the client span of the call of method
+func (t *tracer) Start(ctx context.Context, method string) (context.Context, control.Span) {
+	service, name := method, ""
+	if i := strings.LastIndex(method, "."); i >= 0 {
+		service, name = method[:i], method[i+1:]
+	}
+	ctx, span := t.tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "jsonrpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", name),
+		),
+	)
+	return ctx, &callSpan{span: span}
+}
+
+type callSpan struct {
+	span trace.Span
+}
+
+// End sets attributes of the outcome of the call and ends the span
+func (s *callSpan) End(info control.CallInfo) {
+	if host, port, err := net.SplitHostPort(info.Host); err == nil {
+		s.span.SetAttributes(attribute.String("server.address", host))
+		if p, err := strconv.Atoi(port); err == nil {
+			s.span.SetAttributes(attribute.Int("server.port", p))
+		}
+	}
+	if info.ID != 0 {
+		s.span.SetAttributes(RequestIDKey.Int(info.ID))
+	}
+	s.span.SetAttributes(ResponseSizeKey.Int(info.ResponseSize))
+	if info.Err != nil {
+		var apiErr *control.APIError
+		if errors.As(info.Err, &apiErr) {
+			s.span.SetAttributes(ErrorCodeKey.Int(apiErr.Code), attribute.Int("rpc.jsonrpc.error_code", apiErr.Code))
+		}
+		s.span.RecordError(info.Err)
+		s.span.SetStatus(codes.Error, info.Err.Error())
+	}
+	s.span.End()
+}
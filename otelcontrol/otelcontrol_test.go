@@ -0,0 +1,149 @@
+package otelcontrol_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+	"github.com/igiant/control/otelcontrol"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.SetTracer(otelcontrol.NewTracer(provider))
+	if _, err = conn.SessionGetUserName(); err == nil {
+		t.Fatal("got no error before login")
+	}
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.SessionGetUserName(); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, expected 3", len(spans))
+	}
+	host, port, _ := net.SplitHostPort(fake.Addr())
+	portNumber, _ := strconv.Atoi(port)
+	tests := []struct {
+		name       string
+		service    string
+		method     string
+		errorCode  int
+		attributes int // count of attributes
+	}{
+		{"Session.getUserName", "Session", "getUserName", control.CodeSessionExpired, 9},
+		{"Session.login", "Session", "login", 0, 7},
+		{"Session.getUserName", "Session", "getUserName", 0, 7},
+	}
+	for i, test := range tests {
+		span := spans[i]
+		if span.Name != test.name || span.SpanKind != trace.SpanKindClient {
+			t.Errorf("span %d: got %s of kind %s, expected %s of kind client", i, span.Name, span.SpanKind, test.name)
+		}
+		attrs := attribute.NewSet(span.Attributes...)
+		expected := map[attribute.Key]attribute.Value{
+			"rpc.system":     attribute.StringValue("jsonrpc"),
+			"rpc.service":    attribute.StringValue(test.service),
+			"rpc.method":     attribute.StringValue(test.method),
+			"server.address": attribute.StringValue(host),
+			"server.port":    attribute.IntValue(portNumber),
+		}
+		if test.errorCode != 0 {
+			expected[otelcontrol.ErrorCodeKey] = attribute.IntValue(test.errorCode)
+			expected["rpc.jsonrpc.error_code"] = attribute.IntValue(test.errorCode)
+		}
+		for key, value := range expected {
+			if got, ok := attrs.Value(key); !ok || got != value {
+				t.Errorf("span %d: got %s = %v, expected %v", i, key, got.Emit(), value.Emit())
+			}
+		}
+		if id, ok := attrs.Value(otelcontrol.RequestIDKey); !ok || id.AsInt64() <= 0 {
+			t.Errorf("span %d: got request id %v", i, id.Emit())
+		}
+		if size, ok := attrs.Value(otelcontrol.ResponseSizeKey); !ok || size.AsInt64() <= 0 {
+			t.Errorf("span %d: got response size %v", i, size.Emit())
+		}
+		if attrs.Len() != test.attributes {
+			t.Errorf("span %d: got attributes %v, expected %d", i, span.Attributes, test.attributes)
+		}
+		if test.errorCode != 0 {
+			if span.Status.Code != codes.Error || len(span.Events) != 1 || span.Events[0].Name != "exception" {
+				t.Errorf("span %d: got status %v and events %v, expected the recorded error", i, span.Status, span.Events)
+			}
+		} else if span.Status.Code != codes.Unset {
+			t.Errorf("span %d: got status %v", i, span.Status)
+		}
+	}
+	// request ids of the calls differ
+	firstAttrs, lastAttrs := attribute.NewSet(spans[0].Attributes...), attribute.NewSet(spans[2].Attributes...)
+	first, _ := firstAttrs.Value(otelcontrol.RequestIDKey)
+	last, _ := lastAttrs.Value(otelcontrol.RequestIDKey)
+	if first == last {
+		t.Errorf("got the same request id %v of different calls", first.Emit())
+	}
+}
+
+func TestTracerBatch(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	conn.SetTracer(otelcontrol.NewTracer(provider))
+	batch := conn.NewBatch()
+	batch.Add("Session.getUserName", nil, nil)
+	batch.Add("Missing.get", nil, nil)
+	if err = batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, expected 2", len(spans))
+	}
+	ids := map[int64]bool{}
+	for _, span := range spans {
+		attrs := attribute.NewSet(span.Attributes...)
+		id, _ := attrs.Value(otelcontrol.RequestIDKey)
+		size, _ := attrs.Value(otelcontrol.ResponseSizeKey)
+		if id.AsInt64() <= 0 || size.AsInt64() <= 0 {
+			t.Errorf("%s: got request id %v and response size %v", span.Name, id.Emit(), size.Emit())
+		}
+		ids[id.AsInt64()] = true
+		code, ok := attrs.Value(otelcontrol.ErrorCodeKey)
+		if failed := span.Name == "Missing.get"; failed != ok || (failed && code.AsInt64() != control.CodeMethodNotFound) {
+			t.Errorf("%s: got error code %v", span.Name, code.Emit())
+		}
+	}
+	if len(ids) != 2 {
+		t.Errorf("got request ids %v, expected 2 different", ids)
+	}
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // P2pEliminatorConfig - must be included after BandwidthLimiter
 type P2pEliminatorConfig struct {
@@ -13,7 +16,12 @@ type P2pEliminatorConfig struct {
 // Return
 //	config - structure with configuration of P2P eliminator.
 func (s *ServerConnection) P2pEliminatorGet() (*P2pEliminatorConfig, error) {
-	data, err := s.CallRaw("P2pEliminator.get", nil)
+	return s.P2pEliminatorGetContext(context.Background())
+}
+
+// P2pEliminatorGetContext - P2pEliminatorGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) P2pEliminatorGetContext(ctx context.Context) (*P2pEliminatorConfig, error) {
+	data, err := s.CallRawContext(ctx, "P2pEliminator.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +39,15 @@ func (s *ServerConnection) P2pEliminatorGet() (*P2pEliminatorConfig, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) P2pEliminatorSet(config P2pEliminatorConfig) (ErrorList, error) {
+	return s.P2pEliminatorSetContext(context.Background(), config)
+}
+
+// P2pEliminatorSetContext - P2pEliminatorSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) P2pEliminatorSetContext(ctx context.Context, config P2pEliminatorConfig) (ErrorList, error) {
 	params := struct {
 		Config P2pEliminatorConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("P2pEliminator.set", params)
+	data, err := s.CallRawContext(ctx, "P2pEliminator.set", params)
 	if err != nil {
 		return nil, err
 	}
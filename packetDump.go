@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type PacketDumpStatus struct {
 	SizeKb  int  `json:"sizeKb"`
@@ -12,7 +15,12 @@ type PacketDumpStatus struct {
 // Return
 //  expression - expression for dialog from debug log context menu 'Dump Expression...'
 func (s *ServerConnection) PacketDumpGetExpression() (string, error) {
-	data, err := s.CallRaw("PacketDump.getExpression", nil)
+	return s.PacketDumpGetExpressionContext(context.Background())
+}
+
+// PacketDumpGetExpressionContext - PacketDumpGetExpression with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpGetExpressionContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "PacketDump.getExpression", nil)
 	if err != nil {
 		return "", err
 	}
@@ -28,28 +36,48 @@ func (s *ServerConnection) PacketDumpGetExpression() (string, error) {
 // PacketDumpSetExpression - Stores expression from debug log context menu dialog 'Dump Expression...'
 //  expression - expression for dialog from debug log context menu 'Dump Expression...'
 func (s *ServerConnection) PacketDumpSetExpression(expression string) error {
+	return s.PacketDumpSetExpressionContext(context.Background(), expression)
+}
+
+// PacketDumpSetExpressionContext - PacketDumpSetExpression with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpSetExpressionContext(ctx context.Context, expression string) error {
 	params := struct {
 		Expression string `json:"expression"`
 	}{expression}
-	_, err := s.CallRaw("PacketDump.setExpression", params)
+	_, err := s.CallRawContext(ctx, "PacketDump.setExpression", params)
 	return err
 }
 
 // PacketDumpStart - Start dump handling
 func (s *ServerConnection) PacketDumpStart() error {
-	_, err := s.CallRaw("PacketDump.start", nil)
+	return s.PacketDumpStartContext(context.Background())
+}
+
+// PacketDumpStartContext - PacketDumpStart with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpStartContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "PacketDump.start", nil)
 	return err
 }
 
 // PacketDumpStop - Stop dump handling
 func (s *ServerConnection) PacketDumpStop() error {
-	_, err := s.CallRaw("PacketDump.stop", nil)
+	return s.PacketDumpStopContext(context.Background())
+}
+
+// PacketDumpStopContext - PacketDumpStop with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpStopContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "PacketDump.stop", nil)
 	return err
 }
 
 // PacketDumpClear - Clear dump log
 func (s *ServerConnection) PacketDumpClear() error {
-	_, err := s.CallRaw("PacketDump.clear", nil)
+	return s.PacketDumpClearContext(context.Background())
+}
+
+// PacketDumpClearContext - PacketDumpClear with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpClearContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "PacketDump.clear", nil)
 	return err
 }
 
@@ -57,7 +85,12 @@ func (s *ServerConnection) PacketDumpClear() error {
 // Return
 //  fileDownload - log file information
 func (s *ServerConnection) PacketDumpDownload() (*Download, error) {
-	data, err := s.CallRaw("PacketDump.download", nil)
+	return s.PacketDumpDownloadContext(context.Background())
+}
+
+// PacketDumpDownloadContext - PacketDumpDownload with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpDownloadContext(ctx context.Context) (*Download, error) {
+	data, err := s.CallRawContext(ctx, "PacketDump.download", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +107,12 @@ func (s *ServerConnection) PacketDumpDownload() (*Download, error) {
 // Return
 //  status - dump process information
 func (s *ServerConnection) PacketDumpGetStatus() (*PacketDumpStatus, error) {
-	data, err := s.CallRaw("PacketDump.getStatus", nil)
+	return s.PacketDumpGetStatusContext(context.Background())
+}
+
+// PacketDumpGetStatusContext - PacketDumpGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PacketDumpGetStatusContext(ctx context.Context) (*PacketDumpStatus, error) {
+	data, err := s.CallRawContext(ctx, "PacketDump.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
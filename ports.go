@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type PortAssignmentType string
 
@@ -47,7 +50,12 @@ type PortConfigList []PortConfig
 // Return
 //	list - list of ports sorted by port's order of precedence (WiFi last)
 func (s *ServerConnection) PortsGet() (PortConfigList, error) {
-	data, err := s.CallRaw("Ports.get", nil)
+	return s.PortsGetContext(context.Background())
+}
+
+// PortsGetContext - PortsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PortsGetContext(ctx context.Context) (PortConfigList, error) {
+	data, err := s.CallRawContext(ctx, "Ports.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,11 +77,16 @@ func (s *ServerConnection) PortsGet() (PortConfigList, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) PortsSet(ports PortConfigList, revertTimeout int) (ErrorList, error) {
+	return s.PortsSetContext(context.Background(), ports, revertTimeout)
+}
+
+// PortsSetContext - PortsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) PortsSetContext(ctx context.Context, ports PortConfigList, revertTimeout int) (ErrorList, error) {
 	params := struct {
 		Ports         PortConfigList `json:"ports"`
 		RevertTimeout int            `json:"revertTimeout"`
 	}{ports, revertTimeout}
-	data, err := s.CallRaw("Ports.set", params)
+	data, err := s.CallRawContext(ctx, "Ports.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type WarningType string
 
@@ -69,7 +72,12 @@ const (
 // Return
 //	url - requested url
 func (s *ServerConnection) ProductInfoGetAcknowledgmentsUrl() (string, error) {
-	data, err := s.CallRaw("ProductInfo.getAcknowledgmentsUrl", nil)
+	return s.ProductInfoGetAcknowledgmentsUrlContext(context.Background())
+}
+
+// ProductInfoGetAcknowledgmentsUrlContext - ProductInfoGetAcknowledgmentsUrl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetAcknowledgmentsUrlContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getAcknowledgmentsUrl", nil)
 	if err != nil {
 		return "", err
 	}
@@ -86,7 +94,12 @@ func (s *ServerConnection) ProductInfoGetAcknowledgmentsUrl() (string, error) {
 // Return
 //  productInfo - information about Kerio Server
 func (s *ServerConnection) ProductInfoGet() (*ProductInformation, error) {
-	data, err := s.CallRaw("ProductInfo.get", nil)
+	return s.ProductInfoGetContext(context.Background())
+}
+
+// ProductInfoGetContext - ProductInfoGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetContext(ctx context.Context) (*ProductInformation, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +116,12 @@ func (s *ServerConnection) ProductInfoGet() (*ProductInformation, error) {
 // Return
 //	warnings - list of warnings
 func (s *ServerConnection) ProductInfoGetWarnings() (WarningInfoList, error) {
-	data, err := s.CallRaw("ProductInfo.getWarnings", nil)
+	return s.ProductInfoGetWarningsContext(context.Background())
+}
+
+// ProductInfoGetWarningsContext - ProductInfoGetWarnings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetWarningsContext(ctx context.Context) (WarningInfoList, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getWarnings", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -119,10 +137,15 @@ func (s *ServerConnection) ProductInfoGetWarnings() (WarningInfoList, error) {
 // ProductInfoDisableWarning - Disables given warning
 //  warningType - type of warning to disable
 func (s *ServerConnection) ProductInfoDisableWarning(warningType WarningType) error {
+	return s.ProductInfoDisableWarningContext(context.Background(), warningType)
+}
+
+// ProductInfoDisableWarningContext - ProductInfoDisableWarning with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoDisableWarningContext(ctx context.Context, warningType WarningType) error {
 	params := struct {
 		WarningType WarningType `json:"warningType"`
 	}{warningType}
-	_, err := s.CallRaw("ProductInfo.disableWarning", params)
+	_, err := s.CallRawContext(ctx, "ProductInfo.disableWarning", params)
 	return err
 }
 
@@ -130,7 +153,12 @@ func (s *ServerConnection) ProductInfoDisableWarning(warningType WarningType) er
 // Return
 //  hostname - WinRoute server name
 func (s *ServerConnection) ProductInfoGetSystemHostname() (string, error) {
-	data, err := s.CallRaw("ProductInfo.getSystemHostname", nil)
+	return s.ProductInfoGetSystemHostnameContext(context.Background())
+}
+
+// ProductInfoGetSystemHostnameContext - ProductInfoGetSystemHostname with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetSystemHostnameContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getSystemHostname", nil)
 	if err != nil {
 		return "", err
 	}
@@ -145,22 +173,37 @@ func (s *ServerConnection) ProductInfoGetSystemHostname() (string, error) {
 
 // ProductInfoConfigUpdate - Performs configuration update
 func (s *ServerConnection) ProductInfoConfigUpdate() error {
-	_, err := s.CallRaw("ProductInfo.configUpdate", nil)
+	return s.ProductInfoConfigUpdateContext(context.Background())
+}
+
+// ProductInfoConfigUpdateContext - ProductInfoConfigUpdate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoConfigUpdateContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "ProductInfo.configUpdate", nil)
 	return err
 }
 
 // ProductInfoUploadLicense - Handles license import
 func (s *ServerConnection) ProductInfoUploadLicense(fileId string) error {
+	return s.ProductInfoUploadLicenseContext(context.Background(), fileId)
+}
+
+// ProductInfoUploadLicenseContext - ProductInfoUploadLicense with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoUploadLicenseContext(ctx context.Context, fileId string) error {
 	params := struct {
 		FileId string `json:"fileId"`
 	}{fileId}
-	_, err := s.CallRaw("ProductInfo.uploadLicense", params)
+	_, err := s.CallRawContext(ctx, "ProductInfo.uploadLicense", params)
 	return err
 }
 
 // ProductInfoAcceptUnregisteredTrial - Accepts unregistered trial license in engine and causes
 func (s *ServerConnection) ProductInfoAcceptUnregisteredTrial() error {
-	_, err := s.CallRaw("ProductInfo.acceptUnregisteredTrial", nil)
+	return s.ProductInfoAcceptUnregisteredTrialContext(context.Background())
+}
+
+// ProductInfoAcceptUnregisteredTrialContext - ProductInfoAcceptUnregisteredTrial with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoAcceptUnregisteredTrialContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "ProductInfo.acceptUnregisteredTrial", nil)
 	return err
 }
 
@@ -168,7 +211,12 @@ func (s *ServerConnection) ProductInfoAcceptUnregisteredTrial() error {
 // Return
 //  fileDownload - info file url for download
 func (s *ServerConnection) ProductInfoGetSupportInfo() (*Download, error) {
-	data, err := s.CallRaw("ProductInfo.getSupportInfo", nil)
+	return s.ProductInfoGetSupportInfoContext(context.Background())
+}
+
+// ProductInfoGetSupportInfoContext - ProductInfoGetSupportInfo with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetSupportInfoContext(ctx context.Context) (*Download, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getSupportInfo", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +233,12 @@ func (s *ServerConnection) ProductInfoGetSupportInfo() (*Download, error) {
 // Return
 //  setting - Client statistics enabled/disabled
 func (s *ServerConnection) ProductInfoGetClientStatistics() (bool, error) {
-	data, err := s.CallRaw("ProductInfo.getClientStatistics", nil)
+	return s.ProductInfoGetClientStatisticsContext(context.Background())
+}
+
+// ProductInfoGetClientStatisticsContext - ProductInfoGetClientStatistics with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetClientStatisticsContext(ctx context.Context) (bool, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getClientStatistics", nil)
 	if err != nil {
 		return false, err
 	}
@@ -201,19 +254,29 @@ func (s *ServerConnection) ProductInfoGetClientStatistics() (bool, error) {
 // ProductInfoSetClientStatistics - Stores settings of Client statistics (Enabled/Disabled)
 //  setting - Client statistics enabled/disabled
 func (s *ServerConnection) ProductInfoSetClientStatistics(setting bool) error {
+	return s.ProductInfoSetClientStatisticsContext(context.Background(), setting)
+}
+
+// ProductInfoSetClientStatisticsContext - ProductInfoSetClientStatistics with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoSetClientStatisticsContext(ctx context.Context, setting bool) error {
 	params := struct {
 		Setting bool `json:"setting"`
 	}{setting}
-	_, err := s.CallRaw("ProductInfo.setClientStatistics", params)
+	_, err := s.CallRawContext(ctx, "ProductInfo.setClientStatistics", params)
 	return err
 }
 
 // ProductInfoSetStatisticsData - Stores voluntary statistics obtained on clinet side by javascript.
 func (s *ServerConnection) ProductInfoSetStatisticsData(data StatisticsData) error {
+	return s.ProductInfoSetStatisticsDataContext(context.Background(), data)
+}
+
+// ProductInfoSetStatisticsDataContext - ProductInfoSetStatisticsData with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoSetStatisticsDataContext(ctx context.Context, data StatisticsData) error {
 	params := struct {
 		Data StatisticsData `json:"data"`
 	}{data}
-	_, err := s.CallRaw("ProductInfo.setStatisticsData", params)
+	_, err := s.CallRawContext(ctx, "ProductInfo.setStatisticsData", params)
 	return err
 }
 
@@ -221,7 +284,12 @@ func (s *ServerConnection) ProductInfoSetStatisticsData(data StatisticsData) err
 // Return
 //  uptime - engine uptime
 func (s *ServerConnection) ProductInfoGetUptime() (int, error) {
-	data, err := s.CallRaw("ProductInfo.getUptime", nil)
+	return s.ProductInfoGetUptimeContext(context.Background())
+}
+
+// ProductInfoGetUptimeContext - ProductInfoGetUptime with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetUptimeContext(ctx context.Context) (int, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getUptime", nil)
 	if err != nil {
 		return 0, err
 	}
@@ -239,7 +307,12 @@ func (s *ServerConnection) ProductInfoGetUptime() (int, error) {
 //  devices - number devices
 //	accounts - number accounts
 func (s *ServerConnection) ProductInfoGetUsedDevicesCount() (int, int, error) {
-	data, err := s.CallRaw("ProductInfo.getUsedDevicesCount", nil)
+	return s.ProductInfoGetUsedDevicesCountContext(context.Background())
+}
+
+// ProductInfoGetUsedDevicesCountContext - ProductInfoGetUsedDevicesCount with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoGetUsedDevicesCountContext(ctx context.Context) (int, int, error) {
+	data, err := s.CallRawContext(ctx, "ProductInfo.getUsedDevicesCount", nil)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -256,9 +329,14 @@ func (s *ServerConnection) ProductInfoGetUsedDevicesCount() (int, int, error) {
 // ProductInfoAccountUsage - Accounts usage of ApiEntity for voluntary statistics
 //	apiEntity - which entity was used
 func (s *ServerConnection) ProductInfoAccountUsage(apiEntity ApiEntity) error {
+	return s.ProductInfoAccountUsageContext(context.Background(), apiEntity)
+}
+
+// ProductInfoAccountUsageContext - ProductInfoAccountUsage with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductInfoAccountUsageContext(ctx context.Context, apiEntity ApiEntity) error {
 	params := struct {
 		ApiEntity ApiEntity `json:"apiEntity"`
 	}{apiEntity}
-	_, err := s.CallRaw("ProductInfo.accountUsage", params)
+	_, err := s.CallRawContext(ctx, "ProductInfo.accountUsage", params)
 	return err
 }
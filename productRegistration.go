@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // @brief ineger value used in KISS as "UNLIMITED" in license, see Registration::subscribers and RegistrationFullStatus::users below
 const unlimitedUsers int = -2
@@ -147,13 +150,18 @@ type RegistrationFullStatus struct {
 //	registrationInfo - Registration data retrieved from server by getRegistrationInfo() and modified by user.
 //	finishType - how to finish the registration? Create a new one, modyfy an existing or just download an existing license?
 func (s *ServerConnection) ProductRegistrationFinish(token string, baseId string, registrationInfo Registration, finishType RegistrationFinishType) error {
+	return s.ProductRegistrationFinishContext(context.Background(), token, baseId, registrationInfo, finishType)
+}
+
+// ProductRegistrationFinishContext - ProductRegistrationFinish with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationFinishContext(ctx context.Context, token string, baseId string, registrationInfo Registration, finishType RegistrationFinishType) error {
 	params := struct {
 		Token            string                 `json:"token"`
 		BaseId           string                 `json:"baseId"`
 		RegistrationInfo Registration           `json:"registrationInfo"`
 		FinishType       RegistrationFinishType `json:"finishType"`
 	}{token, baseId, registrationInfo, finishType}
-	_, err := s.CallRaw("ProductRegistration.finish", params)
+	_, err := s.CallRawContext(ctx, "ProductRegistration.finish", params)
 	return err
 }
 
@@ -166,12 +174,17 @@ func (s *ServerConnection) ProductRegistrationFinish(token string, baseId string
 //	newRegistration - flag indicates whether the registration has already existed.
 //	trial - trial ID registered on web, do not display registrationInfo and finish immediatelly
 func (s *ServerConnection) ProductRegistrationGet(token string, securityCode string, baseId string) (*Registration, bool, bool, error) {
+	return s.ProductRegistrationGetContext(context.Background(), token, securityCode, baseId)
+}
+
+// ProductRegistrationGetContext - ProductRegistrationGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationGetContext(ctx context.Context, token string, securityCode string, baseId string) (*Registration, bool, bool, error) {
 	params := struct {
 		Token        string `json:"token"`
 		SecurityCode string `json:"securityCode"`
 		BaseId       string `json:"baseId"`
 	}{token, securityCode, baseId}
-	data, err := s.CallRaw("ProductRegistration.get", params)
+	data, err := s.CallRawContext(ctx, "ProductRegistration.get", params)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -190,7 +203,12 @@ func (s *ServerConnection) ProductRegistrationGet(token string, securityCode str
 // Return
 //	status - A current registration status of the product.
 func (s *ServerConnection) ProductRegistrationGetFullStatus() (*RegistrationFullStatus, error) {
-	data, err := s.CallRaw("ProductRegistration.getFullStatus", nil)
+	return s.ProductRegistrationGetFullStatusContext(context.Background())
+}
+
+// ProductRegistrationGetFullStatusContext - ProductRegistrationGetFullStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationGetFullStatusContext(ctx context.Context) (*RegistrationFullStatus, error) {
+	data, err := s.CallRawContext(ctx, "ProductRegistration.getFullStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +225,12 @@ func (s *ServerConnection) ProductRegistrationGetFullStatus() (*RegistrationFull
 // Return
 //	status - Current registration status of the product.
 func (s *ServerConnection) ProductRegistrationGetStatus() (*RegistrationStatus, error) {
-	data, err := s.CallRaw("ProductRegistration.getStatus", nil)
+	return s.ProductRegistrationGetStatusContext(context.Background())
+}
+
+// ProductRegistrationGetStatusContext - ProductRegistrationGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationGetStatusContext(ctx context.Context) (*RegistrationStatus, error) {
+	data, err := s.CallRawContext(ctx, "ProductRegistration.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -227,10 +250,15 @@ func (s *ServerConnection) ProductRegistrationGetStatus() (*RegistrationStatus,
 //	image - URL of the image with the security code
 //	showImage - show captcha image in wizard if true
 func (s *ServerConnection) ProductRegistrationStart(langId string) (string, string, bool, error) {
+	return s.ProductRegistrationStartContext(context.Background(), langId)
+}
+
+// ProductRegistrationStartContext - ProductRegistrationStart with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationStartContext(ctx context.Context, langId string) (string, string, bool, error) {
 	params := struct {
 		LangId string `json:"langId"`
 	}{langId}
-	data, err := s.CallRaw("ProductRegistration.start", params)
+	data, err := s.CallRawContext(ctx, "ProductRegistration.start", params)
 	if err != nil {
 		return "", "", false, err
 	}
@@ -256,12 +284,17 @@ func (s *ServerConnection) ProductRegistrationStart(langId string) (string, stri
 //	users - the count of users connected to the license
 //	expirationDate - licence expiration date
 func (s *ServerConnection) ProductRegistrationVerifyNumber(token string, baseId string, regNumbersToVerify RegStringList) (ErrorList, RegistrationNumberList, bool, int, *RegDate, error) {
+	return s.ProductRegistrationVerifyNumberContext(context.Background(), token, baseId, regNumbersToVerify)
+}
+
+// ProductRegistrationVerifyNumberContext - ProductRegistrationVerifyNumber with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProductRegistrationVerifyNumberContext(ctx context.Context, token string, baseId string, regNumbersToVerify RegStringList) (ErrorList, RegistrationNumberList, bool, int, *RegDate, error) {
 	params := struct {
 		Token              string        `json:"token"`
 		BaseId             string        `json:"baseId"`
 		RegNumbersToVerify RegStringList `json:"regNumbersToVerify"`
 	}{token, baseId, regNumbersToVerify}
-	data, err := s.CallRaw("ProductRegistration.verifyNumber", params)
+	data, err := s.CallRawContext(ctx, "ProductRegistration.verifyNumber", params)
 	if err != nil {
 		return nil, nil, false, 0, nil, err
 	}
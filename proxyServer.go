@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ParentProxyConfig struct {
 	Enabled     bool              `json:"enabled"`
@@ -23,7 +26,12 @@ type ProxyServerConfig struct {
 // Return
 //	config - current configuration
 func (s *ServerConnection) ProxyServerGet() (*ProxyServerConfig, error) {
-	data, err := s.CallRaw("ProxyServer.get", nil)
+	return s.ProxyServerGetContext(context.Background())
+}
+
+// ProxyServerGetContext - ProxyServerGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProxyServerGetContext(ctx context.Context) (*ProxyServerConfig, error) {
+	data, err := s.CallRawContext(ctx, "ProxyServer.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,9 +47,14 @@ func (s *ServerConnection) ProxyServerGet() (*ProxyServerConfig, error) {
 // ProxyServerSet - Sets Proxy server configuration
 //	config - new configuration
 func (s *ServerConnection) ProxyServerSet(config ProxyServerConfig) error {
+	return s.ProxyServerSetContext(context.Background(), config)
+}
+
+// ProxyServerSetContext - ProxyServerSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ProxyServerSetContext(ctx context.Context, config ProxyServerConfig) error {
 	params := struct {
 		Config ProxyServerConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("ProxyServer.set", params)
+	_, err := s.CallRawContext(ctx, "ProxyServer.set", params)
 	return err
 }
@@ -0,0 +1,92 @@
+package control
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// redacted replaces values of secrets
+const redacted = "*****"
+
+// secretParams - params of methods holding secrets under names not recognized by isSecretKey
+var secretParams = map[string][]string{
+	"Totp.totpVerify": {"code"},
+}
+
+// isSecretKey reports whether the member name holds a secret (passwords, session tokens, shared secrets...)
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret") || key == "token" || key == "psk" ||
+		key == "community"
+}
+
+// isPassword reports whether the object is of type Password, which holds a secret under any member name
+func isPassword(v map[string]interface{}) bool {
+	if len(v) != 2 {
+		return false
+	}
+	_, value := v["value"]
+	_, isSet := v["isSet"].(bool)
+	return value && isSet
+}
+
+// redactJSON returns data with values of secret members replaced by redacted
+func redactJSON(data []byte) []byte {
+	return redactParams("", data)
+}
+
+// redactParams returns params of method with values of secret members replaced by redacted
+func redactParams(method string, data []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return data
+	}
+	if params, ok := v.(map[string]interface{}); ok {
+		for _, key := range secretParams[method] {
+			if _, ok := params[key]; ok {
+				params[key] = redacted
+			}
+		}
+	}
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return data
+	}
+	return redacted
+}
+
+// redactValue returns v, decoded from JSON, with values of secret members replaced
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if isPassword(v) {
+			if s, ok := v["value"].(string); !ok || s != "" {
+				v["value"] = redacted
+			}
+			return v
+		}
+		for key, value := range v {
+			if isSecretKey(key) {
+				// flags like passwordChanged reveal nothing
+				if _, ok := value.(bool); ok {
+					continue
+				}
+				// whether Password is set reveals nothing as well
+				if p, ok := value.(map[string]interface{}); ok && isPassword(p) {
+					v[key] = redactValue(p)
+					continue
+				}
+				if s, ok := value.(string); !ok || s != "" {
+					v[key] = redacted
+				}
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
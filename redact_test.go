@@ -0,0 +1,89 @@
+package control
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRedactParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		value    interface{} // encoded to JSON, unless it is a string
+		expected string
+	}{
+		{
+			"Password under another name", "Snmp.set",
+			SnmpSettings{Community: Password{Value: "s3cret", IsSet: true}, Username: "snmp", Password: Password{Value: "pa55", IsSet: true}},
+			`{"community":{"isSet":true,"value":"*****"},"contact":"","enabled":false,"location":"","password":{"isSet":true,"value":"*****"},"username":"snmp","version":""}`,
+		},
+		{
+			"Password in list", "",
+			[]Password{{Value: "s3cret", IsSet: true}, {Value: "", IsSet: false}},
+			`[{"isSet":true,"value":"*****"},{"isSet":false,"value":""}]`,
+		},
+		{
+			"CredentialsConfig", "Users.create",
+			CredentialsConfig{UserName: "jdoe", Password: "s3cret", PasswordChanged: true},
+			`{"password":"*****","passwordChanged":true,"userName":"jdoe"}`,
+		},
+		{
+			"empty password", "Users.create",
+			CredentialsConfig{UserName: "jdoe"},
+			`{"password":"","passwordChanged":false,"userName":"jdoe"}`,
+		},
+		{
+			"Credentials", "",
+			Credentials{UserName: "jdoe", Password: "s3cret"},
+			`{"password":"*****","userName":"jdoe"}`,
+		},
+		{
+			"P12", "Certificates.importCertificateP12",
+			`{"fileId":"f1","name":"web","certificateType":"LocalCertificate","password":"s3cret"}`,
+			`{"certificateType":"LocalCertificate","fileId":"f1","name":"web","password":"*****"}`,
+		},
+		{
+			"login", "Session.login",
+			`{"userName":"admin","password":"s3cret","application":{"name":"app","vendor":"v","version":"1"}}`,
+			`{"application":{"name":"app","vendor":"v","version":"1"},"password":"*****","userName":"admin"}`,
+		},
+		{
+			"token", "",
+			`{"jsonrpc":"2.0","id":1,"result":{"token":"0123abcd"}}`,
+			`{"id":1,"jsonrpc":"2.0","result":{"token":"*****"}}`,
+		},
+		{
+			"2 step verification code", "Totp.totpVerify",
+			`{"code":123456,"remember":false}`,
+			`{"code":"*****","remember":false}`,
+		},
+		{
+			"code of another method", "Alerts.get",
+			`{"code":1002}`,
+			`{"code":1002}`,
+		},
+		{
+			"shared secret", "",
+			`{"psk":"s3cret","sharedSecret":"s3cret","secretSet":true}`,
+			`{"psk":"*****","secretSet":true,"sharedSecret":"*****"}`,
+		},
+		{
+			"invalid JSON", "",
+			`{"password":`,
+			`{"password":`,
+		},
+	}
+	for _, test := range tests {
+		data, ok := test.value.(string)
+		if !ok {
+			encoded, err := json.Marshal(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data = string(encoded)
+		}
+		if got := string(redactParams(test.method, []byte(data))); got != test.expected {
+			t.Errorf("%s: got %s, expected %s", test.name, got, test.expected)
+		}
+	}
+}
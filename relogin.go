@@ -0,0 +1,82 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// CredentialsFunc returns the user name and password used to log in again when the session expires
+type CredentialsFunc func(ctx context.Context) (userName string, password string, err error)
+
+// StaticCredentials returns CredentialsFunc always returning userName and password
+func StaticCredentials(userName, password string) CredentialsFunc {
+	return func(context.Context) (string, string, error) {
+		return userName, password, nil
+	}
+}
+
+type relogin struct {
+	mu          sync.Mutex
+	credentials CredentialsFunc
+	application *ApiApplication
+	totp        TotpCodeFunc // code of the 2 step verification, see SetReloginTOTP
+}
+
+// SetRelogin enables automatic re-login: when a call fails because the session expired,
+// the connection logs in again with credentials and application and replays the failed call once.
+// Concurrent callers are serialised, so only one re-login is done for the expired session.
+// Passing nil credentials disables automatic re-login. The 2 step verification set by SetReloginTOTP is reset.
+//	credentials - provider of the user name and password, see StaticCredentials
+//	application - client application description
+func (s *ServerConnection) SetRelogin(credentials CredentialsFunc, application *ApiApplication) {
+	if credentials == nil {
+		s.relogin = nil
+		return
+	}
+	s.relogin = &relogin{
+		credentials: credentials,
+		application: application,
+	}
+}
+
+// noReloginKey - key of the context of calls which must not log in again, e.g. of the check of a loaded session
+type noReloginKey struct{}
+
+// canRelogin reports whether the failed call of method may be recovered by logging in again
+func (s *ServerConnection) canRelogin(ctx context.Context, method string, err error) bool {
+	if s.relogin == nil || !errors.Is(err, ErrSessionExpired) || ctx.Value(noReloginKey{}) != nil {
+		return false
+	}
+	switch method {
+	case "Session.login", "Session.logout", "Totp.totpState", "Totp.totpVerify":
+		// failing calls of the login itself must not log in again
+		return false
+	}
+	return true
+}
+
+// renewSession logs in again, unless the session with expired token was already renewed by another caller
+func (s *ServerConnection) renewSession(ctx context.Context, expired *string) error {
+	r := s.relogin
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s.token() != expired {
+		return nil
+	}
+	userName, password, err := r.credentials(ctx)
+	if err != nil {
+		return err
+	}
+	if s.metrics != nil {
+		s.metrics.ObserveRelogin(s.Config.host())
+	}
+	s.setToken(nil)
+	if err = s.LoginContext(ctx, userName, password, r.application); err != nil {
+		return err
+	}
+	if r.totp != nil {
+		return s.verifyTOTP(ctx, r.totp, false)
+	}
+	return nil
+}
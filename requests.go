@@ -2,19 +2,35 @@ package control
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/cookiejar"
+	"sync"
+	"time"
 )
 
+// ServerConnection - connection to the API server.
+// Methods of ServerConnection are safe for concurrent use by multiple goroutines,
+// as long as it is configured (e.g. by SetRelogin) before the calls are started
+// and Token is not modified directly during them.
 type ServerConnection struct {
-	Config *Config
-	Token  *string
-	client *http.Client
+	Config  *Config
+	Token   *string // session token, use of the field is guarded by tokenMu
+	tokenMu sync.RWMutex
+	client  *http.Client
+	relogin *relogin
+	logger  *callLogger
+	tracer  Tracer
+	metrics Metrics
+	// middlewares and the chain built of them by Use
+	middlewares []Middleware
+	chain       RoundTripper
 }
 
 func (c *Config) NewConnection() (*ServerConnection, error) {
-	client, err := newClient()
+	client, err := newClient(c)
 	if err != nil {
 		return nil, err
 	}
@@ -25,26 +41,111 @@ func (c *Config) NewConnection() (*ServerConnection, error) {
 	return &connection, nil
 }
 
-func newClient() (*http.Client, error) {
+func newClient(c *Config) (*http.Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, err
 	}
-	return &http.Client{Jar: jar}, nil
+	if c.transport.client != nil {
+		client := *c.transport.client
+		if client.Jar == nil {
+			client.Jar = jar
+		}
+		return &client, nil
+	}
+	client := &http.Client{Jar: jar}
+	tlsConfig := c.clientTLSConfig()
+	if tlsConfig != nil || c.transport.customized() {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if tlsConfig != nil {
+			transport.TLSClientConfig = tlsConfig
+		}
+		c.transport.apply(transport)
+		client.Transport = transport
+	}
+	return client, nil
 }
 
+// CallRaw performs the JSON-RPC call of method with params and returns the raw response
 func (s *ServerConnection) CallRaw(method string, params interface{}) ([]byte, error) {
-	buffer, err := marshal(s.Config.getID(), method, s.Token, params)
+	return s.CallRawContext(context.Background(), method, params)
+}
+
+// CallRawContext is like CallRaw, but the HTTP request is bound to ctx,
+// so the call is aborted when ctx is cancelled or its deadline expires
+func (s *ServerConnection) CallRawContext(ctx context.Context, method string, params interface{}) ([]byte, error) {
+	var rt RoundTripper = RoundTripperFunc(s.roundTrip)
+	if s.chain != nil {
+		rt = s.chain
+	}
+	if s.tracer != nil {
+		return s.traceCall(ctx, rt, method, params)
+	}
+	return rt.RoundTrip(ctx, method, params)
+}
+
+// roundTrip is the innermost RoundTripper of the middleware chain
+func (s *ServerConnection) roundTrip(ctx context.Context, method string, params interface{}) ([]byte, error) {
+	token := s.token()
+	data, err := s.send(ctx, method, token, params)
+	if err != nil && s.canRelogin(ctx, method, err) {
+		if err = s.renewSession(ctx, token); err != nil {
+			return nil, err
+		}
+		return s.call(ctx, method, s.token(), params)
+	}
+	return data, err
+}
+
+func (s *ServerConnection) token() *string {
+	s.tokenMu.RLock()
+	defer s.tokenMu.RUnlock()
+	return s.Token
+}
+
+func (s *ServerConnection) setToken(token *string) {
+	s.tokenMu.Lock()
+	s.Token = token
+	s.tokenMu.Unlock()
+}
+
+func (s *ServerConnection) call(ctx context.Context, method string, token *string, params interface{}) ([]byte, error) {
+	id := s.Config.getID()
+	setCallID(ctx, id)
+	buffer, err := marshal(id, method, token, params)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", s.Config.url, bytes.NewBuffer(buffer))
+	release, err := s.Config.acquire(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	postCtx, cancel := s.Config.withTimeout(ctx, method)
+	data, err := s.post(postCtx, token, buffer)
+	cancel()
+	release()
+	setCallResponseSize(ctx, len(data))
+	if err == nil {
+		err = checkError(data)
+	}
+	s.log(method, id, start, params, data, err)
+	s.observe(method, start, len(buffer), len(data), err)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// post sends JSON-RPC request body to the server and returns the response body
+func (s *ServerConnection) post(ctx context.Context, token *string, buffer []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.Config.url, bytes.NewBuffer(buffer))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "ApiApplication/json-rpc")
-	if s.Token != nil {
-		req.Header.Add("X-Token", *s.Token)
+	if token != nil {
+		req.Header.Add("X-Token", *token)
 	}
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -55,8 +156,9 @@ func (s *ServerConnection) CallRaw(method string, params interface{}) ([]byte, e
 	if err != nil {
 		return nil, err
 	}
-	if err = checkError(data); err != nil {
-		return nil, err
+	// errors of the API are reported in JSON, other responses come e.g. from a proxy in front of the server
+	if resp.StatusCode >= http.StatusBadRequest && !json.Valid(data) {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 	return data, nil
 }
@@ -0,0 +1,108 @@
+package control_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// calls - records of calls collected by a Logger
+type calls struct {
+	mu      sync.Mutex
+	entries []control.CallLog
+}
+
+func (c *calls) LogCall(entry control.CallLog) {
+	c.mu.Lock()
+	c.entries = append(c.entries, entry)
+	c.mu.Unlock()
+}
+
+func (c *calls) count(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, entry := range c.entries {
+		if entry.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	config := fake.Config()
+	log := &calls{}
+	conns := make([]*control.ServerConnection, 4)
+	for i := range conns {
+		conn, err := config.NewConnection()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.SetLogger(log, control.LogInfo)
+		if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = conn
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(conn *control.ServerConnection) {
+			defer wg.Done()
+			if _, err := conn.SessionGetUserName(); err != nil {
+				t.Error(err)
+			}
+		}(conns[i%len(conns)])
+	}
+	wg.Wait()
+	// request ids are unique across connections sharing the configuration
+	ids := map[int]bool{}
+	for _, entry := range log.entries {
+		if ids[entry.ID] {
+			t.Errorf("request id %d used twice", entry.ID)
+		}
+		ids[entry.ID] = true
+	}
+	if len(ids) != 64+len(conns) {
+		t.Errorf("got %d requests, expected %d", len(ids), 64+len(conns))
+	}
+}
+
+func TestConcurrentRelogin(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := &calls{}
+	conn.SetLogger(log, control.LogInfo)
+	conn.SetRelogin(control.StaticCredentials(controltest.UserName, controltest.Password), nil)
+	if err = conn.Login(controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	fake.ExpireSessions()
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			name, err := conn.SessionGetUserName()
+			if err != nil {
+				t.Error(err)
+			} else if name != controltest.UserName {
+				t.Errorf("got user %q, expected %q", name, controltest.UserName)
+			}
+		}()
+	}
+	wg.Wait()
+	// the expired session is renewed only once, other callers wait for it
+	if n := log.count("Session.login"); n != 2 {
+		t.Errorf("logged in %d times, expected 2", n)
+	}
+}
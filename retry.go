@@ -0,0 +1,102 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy - retrying of calls failed on transient transport errors (connection reset or refused,
+// timeout, 502/503/504 response of a proxy) with exponential backoff and jitter.
+// Errors reported by the server are never retried.
+type RetryPolicy struct {
+	MaxAttempts    int           // count of attempts including the first one, 3 if zero
+	InitialBackoff time.Duration // delay before the second attempt, 200 ms if zero
+	MaxBackoff     time.Duration // limit of the delay, 5 s if zero
+	Multiplier     float64       // growth of the delay after every attempt, 2 if zero
+	RetryMutating  bool          // retry also methods changing configuration or state, e.g. *.set, *.apply, SystemTasks.reboot
+	// IsReadOnly reports whether method (e.g. "Users.get") may be safely repeated, methods with
+	// the name of the action starting with "get" are considered read-only if nil
+	IsReadOnly func(method string) bool
+}
+
+// Retry returns middleware retrying calls according to policy, see ServerConnection.Use
+func Retry(policy RetryPolicy) Middleware {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 3
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = 200 * time.Millisecond
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = 5 * time.Second
+	}
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 2
+	}
+	if policy.IsReadOnly == nil {
+		policy.IsReadOnly = IsReadOnlyMethod
+	}
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+			if !policy.RetryMutating && !policy.IsReadOnly(method) {
+				return next.RoundTrip(ctx, method, params)
+			}
+			backoff := policy.InitialBackoff
+			for attempt := 1; ; attempt++ {
+				data, err := next.RoundTrip(ctx, method, params)
+				if err == nil || attempt >= policy.MaxAttempts || !isTransient(err) || ctx.Err() != nil {
+					return data, err
+				}
+				// full jitter in the upper half of the backoff
+				delay := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, err
+				case <-timer.C:
+				}
+				backoff = time.Duration(float64(backoff) * policy.Multiplier)
+				if backoff > policy.MaxBackoff {
+					backoff = policy.MaxBackoff
+				}
+			}
+		})
+	}
+}
+
+// IsReadOnlyMethod reports whether method only reads data, i.e. name of its action starts with "get"
+// (Users.get, SystemHealth.getHistogram, UpdateChecker.getStatus...)
+func IsReadOnlyMethod(method string) bool {
+	i := strings.LastIndexByte(method, '.')
+	return strings.HasPrefix(method[i+1:], "get")
+}
+
+// isTransient reports whether err is a transport failure, which may disappear when the call is repeated
+func isTransient(err error) bool {
+	var apiError *APIError
+	if errors.As(err, &apiError) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusError *StatusError
+	if errors.As(err, &statusError) {
+		switch statusError.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netError net.Error
+	return errors.As(err, &netError) && netError.Timeout()
+}
@@ -0,0 +1,168 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyServer - server failing requests as listed in failures, then answering them
+type flakyServer struct {
+	srv      *httptest.Server
+	mu       sync.Mutex
+	failures []string // "502", "reset" closes the connection, "error" responds an error of the API
+	requests int
+}
+
+func newFlakyServer(t *testing.T, policy RetryPolicy, failures ...string) (*flakyServer, *ServerConnection) {
+	s := &flakyServer{failures: failures}
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.srv.Close)
+	config := NewConfig(strings.TrimPrefix(s.srv.URL, "https://"))
+	config.SetHTTPClient(s.srv.Client())
+	conn, err := config.NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Use(Retry(policy))
+	return s, conn
+}
+
+func (s *flakyServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	failure := ""
+	if len(s.failures) > 0 {
+		failure, s.failures = s.failures[0], s.failures[1:]
+	}
+	s.mu.Unlock()
+	switch failure {
+	case "502":
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	case "reset":
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			panic(err)
+		}
+		// closing with zero linger resets the connection
+		if tcp, ok := conn.(interface{ SetLinger(int) error }); ok {
+			_ = tcp.SetLinger(0)
+		}
+		_ = conn.Close()
+	case "error":
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"error":{"code":%d,"message":"failed"}}`, CodeOperationFailed)
+	default:
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	}
+}
+
+func (s *flakyServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		method   string
+		failures []string
+		requests int
+		failed   bool
+	}{
+		{"read on 502", RetryPolicy{}, "Users.get", []string{"502", "502"}, 3, false},
+		{"read on reset connection", RetryPolicy{}, "SystemHealth.getHistogram", []string{"reset"}, 2, false},
+		{"attempts exhausted", RetryPolicy{MaxAttempts: 2}, "Users.get", []string{"502", "502"}, 2, true},
+		{"set not retried", RetryPolicy{}, "Users.set", []string{"502"}, 1, true},
+		{"apply not retried", RetryPolicy{}, "Interfaces.apply", []string{"reset"}, 1, true},
+		{"set retried if mutating allowed", RetryPolicy{RetryMutating: true}, "Users.set", []string{"502"}, 2, false},
+		{"custom read-only", RetryPolicy{IsReadOnly: func(method string) bool { return method == "Logs.search" }}, "Logs.search", []string{"502"}, 2, false},
+		{"error of the API not retried", RetryPolicy{RetryMutating: true}, "Users.get", []string{"error"}, 1, true},
+	}
+	for _, test := range tests {
+		test.policy.InitialBackoff = time.Millisecond
+		server, conn := newFlakyServer(t, test.policy, test.failures...)
+		_, err := conn.CallRawContext(context.Background(), test.method, nil)
+		if failed := err != nil; failed != test.failed {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+		if got := server.count(); got != test.requests {
+			t.Errorf("%s: got %d requests, expected %d", test.name, got, test.requests)
+		}
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	server, conn := newFlakyServer(t, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}, "502", "502")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := conn.CallRawContext(ctx, "Users.get", nil)
+	var statusError *StatusError
+	if !errors.As(err, &statusError) || statusError.StatusCode != http.StatusBadGateway {
+		t.Errorf("got %v, expected the error of the last attempt", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retry waited %s after cancel", elapsed)
+	}
+	if got := server.count(); got != 1 {
+		t.Errorf("got %d requests, expected 1", got)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err       error
+		transient bool
+	}{
+		{&StatusError{StatusCode: http.StatusBadGateway}, true},
+		{&StatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{&StatusError{StatusCode: http.StatusGatewayTimeout}, true},
+		{&StatusError{StatusCode: http.StatusInternalServerError}, false},
+		{&StatusError{StatusCode: http.StatusNotFound}, false},
+		{fmt.Errorf("post: %w", syscall.ECONNRESET), true},
+		{fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{fmt.Errorf("write: %w", syscall.EPIPE), true},
+		{io.EOF, true},
+		{io.ErrUnexpectedEOF, true},
+		{&net.DNSError{IsTimeout: true}, true},
+		{&net.DNSError{IsNotFound: true}, false},
+		{ErrSessionExpired, false},
+		{&APIError{Code: CodeOperationFailed}, false},
+		{context.Canceled, false},
+		{fmt.Errorf("post: %w", context.DeadlineExceeded), false},
+		{errors.New("invalid character"), false},
+	}
+	for _, test := range tests {
+		if got := isTransient(test.err); got != test.transient {
+			t.Errorf("%v: got %v, expected %v", test.err, got, test.transient)
+		}
+	}
+}
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	tests := map[string]bool{
+		"Users.get":                 true,
+		"SystemHealth.getHistogram": true,
+		"UpdateChecker.getStatus":   true,
+		"Users.set":                 false,
+		"Interfaces.apply":          false,
+		"SystemTasks.reboot":        false,
+		"Session.login":             false,
+	}
+	for method, readOnly := range tests {
+		if got := IsReadOnlyMethod(method); got != readOnly {
+			t.Errorf("%s: got %v, expected %v", method, got, readOnly)
+		}
+	}
+}
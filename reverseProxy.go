@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type HttpsServerMode string
 
@@ -13,11 +16,11 @@ const (
 type ReverseProxyRule struct {
 	/*@{ server match */
 	ServerHostname    string          `json:"serverHostname"`
-	ServerHttp        bool            `json:"serverHttp"`           // server on standard HTTP port 80
-	HttpsMode         HttpsServerMode `json:"httpsMode;//"`         // server on standard HTTPS port 443
-	CustomCertificate IdReference     `json:"customCertificate;//"` // HTTPS server certificate ID
+	ServerHttp        bool            `json:"serverHttp"`        // server on standard HTTP port 80
+	HttpsMode         HttpsServerMode `json:"httpsMode"`         // server on standard HTTPS port 443
+	CustomCertificate IdReference     `json:"customCertificate"` // HTTPS server certificate ID
 	/*@{ target connection */
-	TargetServer string `json:"targetServer;//"` // hostname/IPv4/IPv6 + optionally ":<port>"
+	TargetServer string `json:"targetServer"` // hostname/IPv4/IPv6 + optionally ":<port>"
 	TargetHttps  bool   `json:"targetHttps"`
 	/*@{ actions */
 	Antivirus bool `json:"antivirus"`
@@ -39,7 +42,12 @@ type ReverseProxyConfig struct {
 // Return
 //	config - reverse proxy config (enabled, default cert.)
 func (s *ServerConnection) ReverseProxyGet() (*ReverseProxyConfig, error) {
-	data, err := s.CallRaw("ReverseProxy.get", nil)
+	return s.ReverseProxyGetContext(context.Background())
+}
+
+// ReverseProxyGetContext - ReverseProxyGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ReverseProxyGetContext(ctx context.Context) (*ReverseProxyConfig, error) {
+	data, err := s.CallRawContext(ctx, "ReverseProxy.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +65,15 @@ func (s *ServerConnection) ReverseProxyGet() (*ReverseProxyConfig, error) {
 // Return
 //	errors - list of errors TODO Write particular values
 func (s *ServerConnection) ReverseProxySet(config ReverseProxyConfig) (ErrorList, error) {
+	return s.ReverseProxySetContext(context.Background(), config)
+}
+
+// ReverseProxySetContext - ReverseProxySet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ReverseProxySetContext(ctx context.Context, config ReverseProxyConfig) (ErrorList, error) {
 	params := struct {
 		Config ReverseProxyConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("ReverseProxy.set", params)
+	data, err := s.CallRawContext(ctx, "ReverseProxy.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type RouterAdvertisement struct {
 	Id          KId            `json:"id"`
@@ -26,7 +29,12 @@ const (
 // Return
 //	list - list of entries
 func (s *ServerConnection) RouterAdvertisementsGet() (RouterAdvertisementList, error) {
-	data, err := s.CallRaw("RouterAdvertisements.get", nil)
+	return s.RouterAdvertisementsGetContext(context.Background())
+}
+
+// RouterAdvertisementsGetContext - RouterAdvertisementsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsGetContext(ctx context.Context) (RouterAdvertisementList, error) {
+	data, err := s.CallRawContext(ctx, "RouterAdvertisements.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +54,15 @@ func (s *ServerConnection) RouterAdvertisementsGet() (RouterAdvertisementList, e
 // Return
 //	errors - list of errors
 func (s *ServerConnection) RouterAdvertisementsSet(advertisements RouterAdvertisementList) (ErrorList, error) {
+	return s.RouterAdvertisementsSetContext(context.Background(), advertisements)
+}
+
+// RouterAdvertisementsSetContext - RouterAdvertisementsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsSetContext(ctx context.Context, advertisements RouterAdvertisementList) (ErrorList, error) {
 	params := struct {
 		Advertisements RouterAdvertisementList `json:"advertisements"`
 	}{advertisements}
-	data, err := s.CallRaw("RouterAdvertisements.set", params)
+	data, err := s.CallRawContext(ctx, "RouterAdvertisements.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +79,12 @@ func (s *ServerConnection) RouterAdvertisementsSet(advertisements RouterAdvertis
 // Return
 //	config - configuration values
 func (s *ServerConnection) RouterAdvertisementsGetConfig() (*RouterAdvertisementsConfig, error) {
-	data, err := s.CallRaw("RouterAdvertisements.getConfig", nil)
+	return s.RouterAdvertisementsGetConfigContext(context.Background())
+}
+
+// RouterAdvertisementsGetConfigContext - RouterAdvertisementsGetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsGetConfigContext(ctx context.Context) (*RouterAdvertisementsConfig, error) {
+	data, err := s.CallRawContext(ctx, "RouterAdvertisements.getConfig", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +100,15 @@ func (s *ServerConnection) RouterAdvertisementsGetConfig() (*RouterAdvertisement
 // RouterAdvertisementsSetConfig - Stores Router Advertisements configuration
 //	config - configuration values
 func (s *ServerConnection) RouterAdvertisementsSetConfig(config RouterAdvertisementsConfig) error {
+	return s.RouterAdvertisementsSetConfigContext(context.Background(), config)
+}
+
+// RouterAdvertisementsSetConfigContext - RouterAdvertisementsSetConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsSetConfigContext(ctx context.Context, config RouterAdvertisementsConfig) error {
 	params := struct {
 		Config RouterAdvertisementsConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("RouterAdvertisements.setConfig", params)
+	_, err := s.CallRawContext(ctx, "RouterAdvertisements.setConfig", params)
 	return err
 }
 
@@ -93,7 +116,12 @@ func (s *ServerConnection) RouterAdvertisementsSetConfig(config RouterAdvertisem
 // Return
 //	mode - result
 func (s *ServerConnection) RouterAdvertisementsGetMode() (*RouterAdvertisementsModeType, error) {
-	data, err := s.CallRaw("RouterAdvertisements.getMode", nil)
+	return s.RouterAdvertisementsGetModeContext(context.Background())
+}
+
+// RouterAdvertisementsGetModeContext - RouterAdvertisementsGetMode with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsGetModeContext(ctx context.Context) (*RouterAdvertisementsModeType, error) {
+	data, err := s.CallRawContext(ctx, "RouterAdvertisements.getMode", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +137,14 @@ func (s *ServerConnection) RouterAdvertisementsGetMode() (*RouterAdvertisementsM
 // RouterAdvertisementsSetMode - Stores Router Advertisements mode
 //	mode - new value
 func (s *ServerConnection) RouterAdvertisementsSetMode(mode RouterAdvertisementsModeType) error {
+	return s.RouterAdvertisementsSetModeContext(context.Background(), mode)
+}
+
+// RouterAdvertisementsSetModeContext - RouterAdvertisementsSetMode with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RouterAdvertisementsSetModeContext(ctx context.Context, mode RouterAdvertisementsModeType) error {
 	params := struct {
 		Mode RouterAdvertisementsModeType `json:"mode"`
 	}{mode}
-	_, err := s.CallRaw("RouterAdvertisements.setMode", params)
+	_, err := s.CallRawContext(ctx, "RouterAdvertisements.setMode", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type RouteType string
 
@@ -31,7 +34,12 @@ type RouteList []Route
 // Return
 //	routes - a list of routes currently stroed and used by system.
 func (s *ServerConnection) RoutingTableGet() (RouteList, error) {
-	data, err := s.CallRaw("RoutingTable.get", nil)
+	return s.RoutingTableGetContext(context.Background())
+}
+
+// RoutingTableGetContext - RoutingTableGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RoutingTableGetContext(ctx context.Context) (RouteList, error) {
+	data, err := s.CallRawContext(ctx, "RoutingTable.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +56,12 @@ func (s *ServerConnection) RoutingTableGet() (RouteList, error) {
 // Return
 //	routes - a list of routes currently stroed and used by Control.
 func (s *ServerConnection) RoutingTableGetStaticRoutes() (RouteList, error) {
-	data, err := s.CallRaw("RoutingTable.getStaticRoutes", nil)
+	return s.RoutingTableGetStaticRoutesContext(context.Background())
+}
+
+// RoutingTableGetStaticRoutesContext - RoutingTableGetStaticRoutes with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RoutingTableGetStaticRoutesContext(ctx context.Context) (RouteList, error) {
+	data, err := s.CallRawContext(ctx, "RoutingTable.getStaticRoutes", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +79,15 @@ func (s *ServerConnection) RoutingTableGetStaticRoutes() (RouteList, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) RoutingTableSetStaticRoutes(routes RouteList) (ErrorList, error) {
+	return s.RoutingTableSetStaticRoutesContext(context.Background(), routes)
+}
+
+// RoutingTableSetStaticRoutesContext - RoutingTableSetStaticRoutes with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) RoutingTableSetStaticRoutesContext(ctx context.Context, routes RouteList) (ErrorList, error) {
 	params := struct {
 		Routes RouteList `json:"routes"`
 	}{routes}
-	data, err := s.CallRaw("RoutingTable.setStaticRoutes", params)
+	data, err := s.CallRawContext(ctx, "RoutingTable.setStaticRoutes", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // AntiSpoofingConfig - Miscellaneous part
 type AntiSpoofingConfig struct {
@@ -102,7 +105,12 @@ type SecuritySettingsConfig struct {
 // Return
 //	config - structure containig security settings such as macfilter action, name, mac list and belonging interfaces.
 func (s *ServerConnection) SecuritySettingsGet() (*SecuritySettingsConfig, error) {
-	data, err := s.CallRaw("SecuritySettings.get", nil)
+	return s.SecuritySettingsGetContext(context.Background())
+}
+
+// SecuritySettingsGetContext - SecuritySettingsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SecuritySettingsGetContext(ctx context.Context) (*SecuritySettingsConfig, error) {
+	data, err := s.CallRawContext(ctx, "SecuritySettings.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +128,15 @@ func (s *ServerConnection) SecuritySettingsGet() (*SecuritySettingsConfig, error
 // Return
 //	errors - list of errors
 func (s *ServerConnection) SecuritySettingsSet(config SecuritySettingsConfig) (ErrorList, error) {
+	return s.SecuritySettingsSetContext(context.Background(), config)
+}
+
+// SecuritySettingsSetContext - SecuritySettingsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SecuritySettingsSetContext(ctx context.Context, config SecuritySettingsConfig) (ErrorList, error) {
 	params := struct {
 		Config SecuritySettingsConfig `json:"config"`
 	}{config}
-	data, err := s.CallRaw("SecuritySettings.set", params)
+	data, err := s.CallRawContext(ctx, "SecuritySettings.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ServerOs string
 
@@ -46,7 +49,12 @@ type RestrictionList []Restriction
 // Return
 //	os - engine OS. I would like to enumerate where client depends on engine OS but such list will become obsolete soon
 func (s *ServerConnection) ServerGetOs() (*ServerOs, error) {
-	data, err := s.CallRaw("Server.getOs", nil)
+	return s.ServerGetOsContext(context.Background())
+}
+
+// ServerGetOsContext - ServerGetOs with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ServerGetOsContext(ctx context.Context) (*ServerOs, error) {
+	data, err := s.CallRawContext(ctx, "Server.getOs", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +71,12 @@ func (s *ServerConnection) ServerGetOs() (*ServerOs, error) {
 // Return
 //  restrictions - list of restrictions
 func (s *ServerConnection) ServerGetRestrictionList() (RestrictionList, error) {
-	data, err := s.CallRaw("Server.getRestrictionList", nil)
+	return s.ServerGetRestrictionListContext(context.Background())
+}
+
+// ServerGetRestrictionListContext - ServerGetRestrictionList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) ServerGetRestrictionListContext(ctx context.Context) (RestrictionList, error) {
+	data, err := s.CallRawContext(ctx, "Server.getRestrictionList", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type LoginType string
 
@@ -22,7 +25,12 @@ type ClientTimestampList []ClientTimestamp
 // SessionGetCsrfToken - Retrieves an unique session ID intended to be used for CSRF protection in web forms.
 // This ID is different from the session cookie but also remains the same during the session lifetime.
 func (s *ServerConnection) SessionGetCsrfToken() (string, error) {
-	data, err := s.CallRaw("Session.getCsrfToken", nil)
+	return s.SessionGetCsrfTokenContext(context.Background())
+}
+
+// SessionGetCsrfTokenContext - SessionGetCsrfToken with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetCsrfTokenContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "Session.getCsrfToken", nil)
 	if err != nil {
 		return "", err
 	}
@@ -39,7 +47,12 @@ func (s *ServerConnection) SessionGetCsrfToken() (string, error) {
 // Return
 //  name - name os logged user
 func (s *ServerConnection) SessionGetUserName() (string, error) {
-	data, err := s.CallRaw("Session.getUserName", nil)
+	return s.SessionGetUserNameContext(context.Background())
+}
+
+// SessionGetUserNameContext - SessionGetUserName with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetUserNameContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "Session.getUserName", nil)
 	if err != nil {
 		return "", err
 	}
@@ -58,6 +71,11 @@ func (s *ServerConnection) SessionGetUserName() (string, error) {
 //	password - password of the user to be logged in
 //	application - client application description
 func (s *ServerConnection) Login(userName string, password string, application *ApiApplication) error {
+	return s.LoginContext(context.Background(), userName, password, application)
+}
+
+// LoginContext - Login with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LoginContext(ctx context.Context, userName string, password string, application *ApiApplication) error {
 	if application == nil {
 		application = NewApplication("", "", "")
 	}
@@ -67,7 +85,7 @@ func (s *ServerConnection) Login(userName string, password string, application *
 		Password    string         `json:"password"`
 		Application ApiApplication `json:"application"`
 	}{userName, password, *application}
-	data, err := s.CallRaw("Session.login", params)
+	data, err := s.CallRawContext(ctx, "Session.login", params)
 	if err != nil {
 		return err
 	}
@@ -80,13 +98,18 @@ func (s *ServerConnection) Login(userName string, password string, application *
 	if err != nil {
 		return err
 	}
-	s.Token = &token.Result.Token
+	s.setToken(&token.Result.Token)
 	return nil
 }
 
 // Logout - Destroys session
 func (s *ServerConnection) Logout() error {
-	_, err := s.CallRaw("Session.logout", nil)
+	return s.LogoutContext(context.Background())
+}
+
+// LogoutContext - Logout with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) LogoutContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Session.logout", nil)
 	return err
 }
 
@@ -94,10 +117,15 @@ func (s *ServerConnection) Logout() error {
 // Return
 //  value - clients defined variable stored in configuration for logged user
 func (s *ServerConnection) SessionGetSessionVariable(name string) (string, error) {
+	return s.SessionGetSessionVariableContext(context.Background(), name)
+}
+
+// SessionGetSessionVariableContext - SessionGetSessionVariable with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetSessionVariableContext(ctx context.Context, name string) (string, error) {
 	params := struct {
 		Name string `json:"name"`
 	}{name}
-	data, err := s.CallRaw("Session.getSessionVariable", params)
+	data, err := s.CallRawContext(ctx, "Session.getSessionVariable", params)
 	if err != nil {
 		return "", err
 	}
@@ -114,17 +142,27 @@ func (s *ServerConnection) SessionGetSessionVariable(name string) (string, error
 //	name - name of variable
 //  value - value of variable
 func (s *ServerConnection) SessionSetSessionVariable(name string, value string) error {
+	return s.SessionSetSessionVariableContext(context.Background(), name, value)
+}
+
+// SessionSetSessionVariableContext - SessionSetSessionVariable with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionSetSessionVariableContext(ctx context.Context, name string, value string) error {
 	params := struct {
 		Name  string `json:"name"`
 		Value string `json:"value"`
 	}{name, value}
-	_, err := s.CallRaw("Session.setSessionVariable", params)
+	_, err := s.CallRawContext(ctx, "Session.setSessionVariable", params)
 	return err
 }
 
 // SessionReset - Reset all persistent objects (managers) in session
 func (s *ServerConnection) SessionReset() error {
-	_, err := s.CallRaw("Session.reset", nil)
+	return s.SessionResetContext(context.Background())
+}
+
+// SessionResetContext - SessionReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "Session.reset", nil)
 	return err
 }
 
@@ -132,7 +170,12 @@ func (s *ServerConnection) SessionReset() error {
 // Return
 //	clientTimestampList - is empty in case, that cut-off prevention is not active
 func (s *ServerConnection) SessionGetConfigTimestamp() (ClientTimestampList, error) {
-	data, err := s.CallRaw("Session.getConfigTimestamp", nil)
+	return s.SessionGetConfigTimestampContext(context.Background())
+}
+
+// SessionGetConfigTimestampContext - SessionGetConfigTimestamp with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetConfigTimestampContext(ctx context.Context) (ClientTimestampList, error) {
+	data, err := s.CallRawContext(ctx, "Session.getConfigTimestamp", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,10 +193,15 @@ func (s *ServerConnection) SessionGetConfigTimestamp() (ClientTimestampList, err
 // Return
 //	confirmed - true in case, that cut-off prevention was active and timestamp matched last provided timestamp
 func (s *ServerConnection) SessionConfirmConfig(clientTimestampList ClientTimestampList) (bool, error) {
+	return s.SessionConfirmConfigContext(context.Background(), clientTimestampList)
+}
+
+// SessionConfirmConfigContext - SessionConfirmConfig with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionConfirmConfigContext(ctx context.Context, clientTimestampList ClientTimestampList) (bool, error) {
 	params := struct {
 		ClientTimestampList ClientTimestampList `json:"clientTimestampList"`
 	}{clientTimestampList}
-	data, err := s.CallRaw("Session.confirmConfig", params)
+	data, err := s.CallRawContext(ctx, "Session.confirmConfig", params)
 	if err != nil {
 		return false, err
 	}
@@ -170,7 +218,12 @@ func (s *ServerConnection) SessionConfirmConfig(clientTimestampList ClientTimest
 // Return
 //	id - id of interface or empty in case of localhost
 func (s *ServerConnection) SessionGetConnectedInterface() (*KId, error) {
-	data, err := s.CallRaw("Session.getConnectedInterface", nil)
+	return s.SessionGetConnectedInterfaceContext(context.Background())
+}
+
+// SessionGetConnectedInterfaceContext - SessionGetConnectedInterface with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetConnectedInterfaceContext(ctx context.Context) (*KId, error) {
+	data, err := s.CallRawContext(ctx, "Session.getConnectedInterface", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +240,12 @@ func (s *ServerConnection) SessionGetConnectedInterface() (*KId, error) {
 // Return
 //  loginType - type of login
 func (s *ServerConnection) SessionGetLoginType() (*LoginType, error) {
-	data, err := s.CallRaw("Session.getLoginType", nil)
+	return s.SessionGetLoginTypeContext(context.Background())
+}
+
+// SessionGetLoginTypeContext - SessionGetLoginType with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SessionGetLoginTypeContext(ctx context.Context) (*LoginType, error) {
+	data, err := s.CallRawContext(ctx, "Session.getLoginType", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,147 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// savedSession - session stored in a file by SaveSession
+type savedSession struct {
+	URL     string        `json:"url"`
+	Token   string        `json:"token"`
+	Cookies []savedCookie `json:"cookies"`
+}
+
+type savedCookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SaveSession writes the session token and cookies of the connection to file readable only by its owner,
+// so that the session may be resumed by LoadSession, e.g. by the next run of a script.
+// The file grants access to the server like a password, it must be kept private.
+//	path - name of the file, it is replaced atomically
+func (s *ServerConnection) SaveSession(path string) error {
+	token := s.token()
+	if token == nil {
+		return fmt.Errorf("cannot save session: not logged in")
+	}
+	u, err := url.Parse(s.Config.url)
+	if err != nil {
+		return err
+	}
+	session := savedSession{
+		URL:   s.Config.url,
+		Token: *token,
+	}
+	if s.client.Jar != nil {
+		for _, cookie := range s.client.Jar.Cookies(u) {
+			session.Cookies = append(session.Cookies, savedCookie{Name: cookie.Name, Value: cookie.Value})
+		}
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	// the temporary file is created with mode 0600
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+// LoadSession resumes the session saved by SaveSession and checks by SessionGetUserName that it is still valid.
+// The session is not resumed if the file does not exist, belongs to another server or the session expired,
+// the check does not log in again even if SetRelogin is enabled.
+// Return
+//	userName - name of the logged user
+func (s *ServerConnection) LoadSession(ctx context.Context, path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	session := savedSession{}
+	if err = json.Unmarshal(data, &session); err != nil {
+		return "", fmt.Errorf("invalid session file %s: %w", path, err)
+	}
+	if session.URL != s.Config.url {
+		return "", fmt.Errorf("session file %s belongs to %s", path, session.URL)
+	}
+	u, err := url.Parse(s.Config.url)
+	if err != nil {
+		return "", err
+	}
+	// the path of cookies is not known, they are restored for the whole server
+	// so that cookies of the next login replace them
+	cookies := make([]*http.Cookie, len(session.Cookies))
+	for i, cookie := range session.Cookies {
+		cookies[i] = &http.Cookie{Name: cookie.Name, Value: cookie.Value, Path: "/"}
+	}
+	if s.client.Jar != nil {
+		s.client.Jar.SetCookies(u, cookies)
+	}
+	previous := s.token()
+	s.setToken(&session.Token)
+	// an expired session must not be replaced by a new one of SetRelogin, which would not be saved by LoginCached
+	userName, err := s.SessionGetUserNameContext(context.WithValue(ctx, noReloginKey{}, true))
+	if err != nil {
+		s.setToken(previous)
+		if s.client.Jar != nil {
+			for _, cookie := range cookies {
+				cookie.MaxAge = -1
+			}
+			s.client.Jar.SetCookies(u, cookies)
+		}
+		return "", err
+	}
+	return userName, nil
+}
+
+// LoginCached resumes the session of userName saved in file by the previous run, or logs in and saves the new session,
+// so that short-lived programs do not log in and out on every run. Logout is not supposed to be called then.
+//	path - name of the file with the session, see SaveSession
+//	userName - login name + domain name (can be omitted if primary/local) of the user to be logged in
+//	password - password of the user to be logged in
+//	application - client application description
+func (s *ServerConnection) LoginCached(ctx context.Context, path string, userName string, password string, application *ApiApplication) error {
+	name, err := s.LoadSession(ctx, path)
+	if err == nil && sameUser(name, userName) {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := s.LoginContext(ctx, userName, password, application); err != nil {
+		return err
+	}
+	return s.SaveSession(path)
+}
+
+// sameUser reports whether user names are equal, ignoring the domain if one of them omits it
+func sameUser(a, b string) bool {
+	if strings.Contains(a, "@") != strings.Contains(b, "@") {
+		a, b = strings.SplitN(a, "@", 2)[0], strings.SplitN(b, "@", 2)[0]
+	}
+	return strings.EqualFold(a, b)
+}
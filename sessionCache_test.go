@@ -0,0 +1,90 @@
+package control_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// logins counts calls of Session.login passing through the middleware
+type logins struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (l *logins) middleware(next control.RoundTripper) control.RoundTripper {
+	return control.RoundTripperFunc(func(ctx context.Context, method string, params interface{}) ([]byte, error) {
+		if method == "Session.login" {
+			l.mu.Lock()
+			l.count++
+			l.mu.Unlock()
+		}
+		return next.RoundTrip(ctx, method, params)
+	})
+}
+
+// cachedConnection returns connection to fake re-logging in automatically
+func cachedConnection(t *testing.T, fake *controltest.Server) (*control.ServerConnection, *logins) {
+	conn, err := fake.Config().NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.SetRelogin(control.StaticCredentials(controltest.UserName, controltest.Password), nil)
+	l := &logins{}
+	conn.Use(l.middleware)
+	return conn, l
+}
+
+func TestLoginCached(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	path := filepath.Join(t.TempDir(), "session.json")
+	ctx := context.Background()
+
+	first, firstLogins := cachedConnection(t, fake)
+	if err := first.LoginCached(ctx, path, controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	second, secondLogins := cachedConnection(t, fake)
+	if err := second.LoginCached(ctx, path, controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	if firstLogins.count != 1 || secondLogins.count != 0 {
+		t.Errorf("got %d and %d logins, expected the second connection to resume the session", firstLogins.count, secondLogins.count)
+	}
+	saved, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the expired session is not renewed by the check, the new one is saved
+	fake.ExpireSessions()
+	third, thirdLogins := cachedConnection(t, fake)
+	if _, err = third.LoadSession(ctx, path); !errors.Is(err, control.ErrSessionExpired) {
+		t.Errorf("got %v, expected the saved session expired", err)
+	}
+	if err = third.LoginCached(ctx, path, controltest.UserName, controltest.Password, nil); err != nil {
+		t.Fatal(err)
+	}
+	if thirdLogins.count != 1 {
+		t.Errorf("got %d logins, expected 1", thirdLogins.count)
+	}
+	renewed, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(renewed) == string(saved) {
+		t.Error("the new session was not saved")
+	}
+	fourth, fourthLogins := cachedConnection(t, fake)
+	name, err := fourth.LoadSession(ctx, path)
+	if err != nil || name != controltest.UserName || fourthLogins.count != 0 {
+		t.Errorf("got %q, %v and %d logins, expected the renewed session resumed", name, err, fourthLogins.count)
+	}
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // SharedDefinitionType - @brief enumerate types of definitions shared between MyKerio and your appliance
 type SharedDefinitionType string
@@ -25,7 +28,12 @@ type SharedDefinitionInfoList []SharedDefinitionInfo
 // Return
 // list - list of appliance report
 func (s *ServerConnection) SharedDefinitionsGetVersions() (SharedDefinitionInfoList, error) {
-	data, err := s.CallRaw("SharedDefinitions.getVersions", nil)
+	return s.SharedDefinitionsGetVersionsContext(context.Background())
+}
+
+// SharedDefinitionsGetVersionsContext - SharedDefinitionsGetVersions with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SharedDefinitionsGetVersionsContext(ctx context.Context) (SharedDefinitionInfoList, error) {
+	data, err := s.CallRawContext(ctx, "SharedDefinitions.getVersions", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +49,14 @@ func (s *ServerConnection) SharedDefinitionsGetVersions() (SharedDefinitionInfoL
 // SharedDefinitionsSetVersions - Sets appliance report to MyKerio what shared definitions it supports and what versions of shared definitions are stored in appliance
 //	list - list of appliance report
 func (s *ServerConnection) SharedDefinitionsSetVersions(list SharedDefinitionInfoList) error {
+	return s.SharedDefinitionsSetVersionsContext(context.Background(), list)
+}
+
+// SharedDefinitionsSetVersionsContext - SharedDefinitionsSetVersions with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SharedDefinitionsSetVersionsContext(ctx context.Context, list SharedDefinitionInfoList) error {
 	params := struct {
 		List SharedDefinitionInfoList `json:"list"`
 	}{list}
-	_, err := s.CallRaw("SharedDefinitions.setVersions", params)
+	_, err := s.CallRawContext(ctx, "SharedDefinitions.setVersions", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type SmtpRelayConfig struct {
 	UseKManager             bool              `json:"useKManager"`
@@ -15,7 +18,12 @@ type SmtpRelayConfig struct {
 // Return
 //  config - SMTP Relay configuration
 func (s *ServerConnection) SmtpRelayGet() (*SmtpRelayConfig, error) {
-	data, err := s.CallRaw("SmtpRelay.get", nil)
+	return s.SmtpRelayGetContext(context.Background())
+}
+
+// SmtpRelayGetContext - SmtpRelayGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SmtpRelayGetContext(ctx context.Context) (*SmtpRelayConfig, error) {
+	data, err := s.CallRawContext(ctx, "SmtpRelay.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +39,15 @@ func (s *ServerConnection) SmtpRelayGet() (*SmtpRelayConfig, error) {
 // SmtpRelaySet - Stores SMTP Relay configuration
 //  config - SMTP Relay configuration
 func (s *ServerConnection) SmtpRelaySet(config SmtpRelayConfig) error {
+	return s.SmtpRelaySetContext(context.Background(), config)
+}
+
+// SmtpRelaySetContext - SmtpRelaySet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SmtpRelaySetContext(ctx context.Context, config SmtpRelayConfig) error {
 	params := struct {
 		Config SmtpRelayConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("SmtpRelay.set", params)
+	_, err := s.CallRawContext(ctx, "SmtpRelay.set", params)
 	return err
 }
 
@@ -44,11 +57,16 @@ func (s *ServerConnection) SmtpRelaySet(config SmtpRelayConfig) error {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) SmtpRelayTest(config SmtpRelayConfig, address string) (ErrorList, error) {
+	return s.SmtpRelayTestContext(context.Background(), config, address)
+}
+
+// SmtpRelayTestContext - SmtpRelayTest with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SmtpRelayTestContext(ctx context.Context, config SmtpRelayConfig, address string) (ErrorList, error) {
 	params := struct {
 		Config  SmtpRelayConfig `json:"config"`
 		Address string          `json:"address"`
 	}{config, address}
-	data, err := s.CallRaw("SmtpRelay.test", params)
+	data, err := s.CallRawContext(ctx, "SmtpRelay.test", params)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +83,12 @@ func (s *ServerConnection) SmtpRelayTest(config SmtpRelayConfig, address string)
 // Return
 //	errors - list of errors
 func (s *ServerConnection) SmtpRelayGetStatus() (ErrorList, error) {
-	data, err := s.CallRaw("SmtpRelay.getStatus", nil)
+	return s.SmtpRelayGetStatusContext(context.Background())
+}
+
+// SmtpRelayGetStatusContext - SmtpRelayGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SmtpRelayGetStatusContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "SmtpRelay.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
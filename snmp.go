@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type SnmpVersion string
 
@@ -23,7 +26,12 @@ type SnmpSettings struct {
 // Return
 //	settings - A structure containing all the settings of SNMP that are stored.
 func (s *ServerConnection) SnmpGet() (*SnmpSettings, error) {
-	data, err := s.CallRaw("Snmp.get", nil)
+	return s.SnmpGetContext(context.Background())
+}
+
+// SnmpGetContext - SnmpGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SnmpGetContext(ctx context.Context) (*SnmpSettings, error) {
+	data, err := s.CallRawContext(ctx, "Snmp.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,9 +47,14 @@ func (s *ServerConnection) SnmpGet() (*SnmpSettings, error) {
 // SnmpSet - Stores SNMP configuration
 //	settings - A structure containing all the settings of SNMP that sould be stored.
 func (s *ServerConnection) SnmpSet(settings SnmpSettings) error {
+	return s.SnmpSetContext(context.Background(), settings)
+}
+
+// SnmpSetContext - SnmpSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SnmpSetContext(ctx context.Context, settings SnmpSettings) error {
 	params := struct {
 		Settings SnmpSettings `json:"settings"`
 	}{settings}
-	_, err := s.CallRaw("Snmp.set", params)
+	_, err := s.CallRawContext(ctx, "Snmp.set", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type ReportConfig struct {
 	DailyEnabled   bool `json:"dailyEnabled"`
@@ -25,7 +28,12 @@ type StarReportList []StarReport
 //	list - list of entries
 //	allUsers - Structure telling whether to send individual reports to each person reularly and wheter to allow them to see their report online.
 func (s *ServerConnection) StarReportsGet() (StarReportList, *ReportConfig, error) {
-	data, err := s.CallRaw("StarReports.get", nil)
+	return s.StarReportsGetContext(context.Background())
+}
+
+// StarReportsGetContext - StarReportsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) StarReportsGetContext(ctx context.Context) (StarReportList, *ReportConfig, error) {
+	data, err := s.CallRawContext(ctx, "StarReports.get", nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -45,11 +53,16 @@ func (s *ServerConnection) StarReportsGet() (StarReportList, *ReportConfig, erro
 // Return
 //	errors - list of errors
 func (s *ServerConnection) StarReportsSet(reports StarReportList, allUsers ReportConfig) (ErrorList, error) {
+	return s.StarReportsSetContext(context.Background(), reports, allUsers)
+}
+
+// StarReportsSetContext - StarReportsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) StarReportsSetContext(ctx context.Context, reports StarReportList, allUsers ReportConfig) (ErrorList, error) {
 	params := struct {
 		Reports  StarReportList `json:"reports"`
 		AllUsers ReportConfig   `json:"allUsers"`
 	}{reports, allUsers}
-	data, err := s.CallRaw("StarReports.set", params)
+	data, err := s.CallRawContext(ctx, "StarReports.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -68,11 +81,16 @@ func (s *ServerConnection) StarReportsSet(reports StarReportList, allUsers Repor
 // Return
 //	errors - list of errors
 func (s *ServerConnection) StarReportsSend(reports StarReportList, language string) (ErrorList, error) {
+	return s.StarReportsSendContext(context.Background(), reports, language)
+}
+
+// StarReportsSendContext - StarReportsSend with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) StarReportsSendContext(ctx context.Context, reports StarReportList, language string) (ErrorList, error) {
 	params := struct {
 		Reports  StarReportList `json:"reports"`
 		Language string         `json:"language"`
 	}{reports, language}
-	data, err := s.CallRaw("StarReports.send", params)
+	data, err := s.CallRawContext(ctx, "StarReports.send", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type StorageDataType string
 
@@ -25,7 +28,12 @@ type StorageDataList []StorageData
 // Return
 //	data - list of data present on storage.
 func (s *ServerConnection) StorageGet() (StorageDataList, error) {
-	data, err := s.CallRaw("Storage.get", nil)
+	return s.StorageGetContext(context.Background())
+}
+
+// StorageGetContext - StorageGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) StorageGetContext(ctx context.Context) (StorageDataList, error) {
+	data, err := s.CallRawContext(ctx, "Storage.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +49,14 @@ func (s *ServerConnection) StorageGet() (StorageDataList, error) {
 // StorageRemove - Delete data specified by type from storage.
 //  storageDataType - data specified by type from storage.
 func (s *ServerConnection) StorageRemove(storageDataType StorageDataType) error {
+	return s.StorageRemoveContext(context.Background(), storageDataType)
+}
+
+// StorageRemoveContext - StorageRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) StorageRemoveContext(ctx context.Context, storageDataType StorageDataType) error {
 	params := struct {
 		StorageDataType StorageDataType `json:"storageDataType"`
 	}{storageDataType}
-	_, err := s.CallRaw("Storage.remove", params)
+	_, err := s.CallRawContext(ctx, "Storage.remove", params)
 	return err
 }
@@ -8,9 +8,14 @@ type parameters struct {
 	Params  interface{} `json:"params,omitempty"`
 }
 
+// Config - configuration for connecting to the API server.
+// Config may be shared by several connections used from different goroutines.
 type Config struct {
-	url string
-	id  int
+	id        int64 // last request id, accessed atomically; first field for 64-bit alignment on 32-bit platforms
+	url       string
+	tls       tlsOptions
+	transport transportOptions
+	limiter   *limiter // limits of requests set by SetLimits
 }
 
 type loginStruct struct {
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type TimeZoneConfig struct {
 	Id            KId    `json:"id"`
@@ -36,7 +39,12 @@ type NtpUpdateStatus struct {
 // Return
 //	config - actual values for System configuration in WebAdmin
 func (s *ServerConnection) SystemConfigGet() (*SystemConfiguration, error) {
-	data, err := s.CallRaw("SystemConfig.get", nil)
+	return s.SystemConfigGetContext(context.Background())
+}
+
+// SystemConfigGetContext - SystemConfigGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigGetContext(ctx context.Context) (*SystemConfiguration, error) {
+	data, err := s.CallRawContext(ctx, "SystemConfig.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +60,15 @@ func (s *ServerConnection) SystemConfigGet() (*SystemConfiguration, error) {
 // SystemConfigSet - Stores System configuration
 //	config - contains system setting to be stored.
 func (s *ServerConnection) SystemConfigSet(config SystemConfiguration) error {
+	return s.SystemConfigSetContext(context.Background(), config)
+}
+
+// SystemConfigSetContext - SystemConfigSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigSetContext(ctx context.Context, config SystemConfiguration) error {
 	params := struct {
 		Config SystemConfiguration `json:"config"`
 	}{config}
-	_, err := s.CallRaw("SystemConfig.set", params)
+	_, err := s.CallRawContext(ctx, "SystemConfig.set", params)
 	return err
 }
 
@@ -64,10 +77,15 @@ func (s *ServerConnection) SystemConfigSet(config SystemConfiguration) error {
 // Return
 //	timeZones - list of known timezones.
 func (s *ServerConnection) SystemConfigGetTimeZones(currentDate Date) (TimeZoneConfigList, error) {
+	return s.SystemConfigGetTimeZonesContext(context.Background(), currentDate)
+}
+
+// SystemConfigGetTimeZonesContext - SystemConfigGetTimeZones with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigGetTimeZonesContext(ctx context.Context, currentDate Date) (TimeZoneConfigList, error) {
 	params := struct {
 		CurrentDate Date `json:"currentDate"`
 	}{currentDate}
-	data, err := s.CallRaw("SystemConfig.getTimeZones", params)
+	data, err := s.CallRawContext(ctx, "SystemConfig.getTimeZones", params)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +102,12 @@ func (s *ServerConnection) SystemConfigGetTimeZones(currentDate Date) (TimeZoneC
 // Return
 //	config - Returns Date and Time for System configuration.
 func (s *ServerConnection) SystemConfigGetDateTime() (*DateTimeConfig, error) {
-	data, err := s.CallRaw("SystemConfig.getDateTime", nil)
+	return s.SystemConfigGetDateTimeContext(context.Background())
+}
+
+// SystemConfigGetDateTimeContext - SystemConfigGetDateTime with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigGetDateTimeContext(ctx context.Context) (*DateTimeConfig, error) {
+	data, err := s.CallRawContext(ctx, "SystemConfig.getDateTime", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,16 +123,26 @@ func (s *ServerConnection) SystemConfigGetDateTime() (*DateTimeConfig, error) {
 // SystemConfigSetDateTime - Stores Date and Time for System configuration.
 //	config - structure of system date and time settings
 func (s *ServerConnection) SystemConfigSetDateTime(config DateTimeConfig) error {
+	return s.SystemConfigSetDateTimeContext(context.Background(), config)
+}
+
+// SystemConfigSetDateTimeContext - SystemConfigSetDateTime with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigSetDateTimeContext(ctx context.Context, config DateTimeConfig) error {
 	params := struct {
 		Config DateTimeConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("SystemConfig.setDateTime", params)
+	_, err := s.CallRawContext(ctx, "SystemConfig.setDateTime", params)
 	return err
 }
 
 // SystemConfigSetTimeFromNtp - Starts NTP client based on configured values.
 func (s *ServerConnection) SystemConfigSetTimeFromNtp() error {
-	_, err := s.CallRaw("SystemConfig.setTimeFromNtp", nil)
+	return s.SystemConfigSetTimeFromNtpContext(context.Background())
+}
+
+// SystemConfigSetTimeFromNtpContext - SystemConfigSetTimeFromNtp with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigSetTimeFromNtpContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "SystemConfig.setTimeFromNtp", nil)
 	return err
 }
 
@@ -117,7 +150,12 @@ func (s *ServerConnection) SystemConfigSetTimeFromNtp() error {
 // Return
 //	status - Status of NTP client process.
 func (s *ServerConnection) SystemConfigGetNtpStatus() (*NtpUpdateStatus, error) {
-	data, err := s.CallRaw("SystemConfig.getNtpStatus", nil)
+	return s.SystemConfigGetNtpStatusContext(context.Background())
+}
+
+// SystemConfigGetNtpStatusContext - SystemConfigGetNtpStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemConfigGetNtpStatusContext(ctx context.Context) (*NtpUpdateStatus, error) {
+	data, err := s.CallRawContext(ctx, "SystemConfig.getNtpStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type HistogramType string
 
@@ -33,10 +36,15 @@ type SystemHealthData struct {
 
 // SystemHealthGet - Gets state of the operating system the product is running on.
 func (s *ServerConnection) SystemHealthGet(histogramType HistogramType) (*SystemHealthData, error) {
+	return s.SystemHealthGetContext(context.Background(), histogramType)
+}
+
+// SystemHealthGetContext - SystemHealthGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemHealthGetContext(ctx context.Context, histogramType HistogramType) (*SystemHealthData, error) {
 	params := struct {
 		HistogramType HistogramType `json:"histogramType"`
 	}{histogramType}
-	data, err := s.CallRaw("SystemHealth.get", params)
+	data, err := s.CallRawContext(ctx, "SystemHealth.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -51,11 +59,16 @@ func (s *ServerConnection) SystemHealthGet(histogramType HistogramType) (*System
 
 // SystemHealthGetInc - Gets state of the operating system the product in time interval
 func (s *ServerConnection) SystemHealthGetInc(histogramIntervalType HistogramIntervalType, startSampleTime DateTimeStamp) (*SystemHealthData, *DateTimeStamp, error) {
+	return s.SystemHealthGetIncContext(context.Background(), histogramIntervalType, startSampleTime)
+}
+
+// SystemHealthGetIncContext - SystemHealthGetInc with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemHealthGetIncContext(ctx context.Context, histogramIntervalType HistogramIntervalType, startSampleTime DateTimeStamp) (*SystemHealthData, *DateTimeStamp, error) {
 	params := struct {
 		HistogramIntervalType HistogramIntervalType `json:"histogramIntervalType"`
 		StartSampleTime       DateTimeStamp         `json:"startSampleTime"`
 	}{histogramIntervalType, startSampleTime}
-	data, err := s.CallRaw("SystemHealth.getInc", params)
+	data, err := s.CallRawContext(ctx, "SystemHealth.getInc", params)
 	if err != nil {
 		return nil, nil, err
 	}
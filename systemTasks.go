@@ -1,12 +1,20 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // SystemTasksGetSsh - Returns SSH status
 // Return
 //	running - SSH is running
 func (s *ServerConnection) SystemTasksGetSsh() (bool, error) {
-	data, err := s.CallRaw("SystemTasks.getSsh", nil)
+	return s.SystemTasksGetSshContext(context.Background())
+}
+
+// SystemTasksGetSshContext - SystemTasksGetSsh with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemTasksGetSshContext(ctx context.Context) (bool, error) {
+	data, err := s.CallRawContext(ctx, "SystemTasks.getSsh", nil)
 	if err != nil {
 		return false, err
 	}
@@ -22,21 +30,36 @@ func (s *ServerConnection) SystemTasksGetSsh() (bool, error) {
 // SystemTasksSetSsh - Sets SSH setting
 //	enable - true - enable SSH, false - disable SSH
 func (s *ServerConnection) SystemTasksSetSsh(enable bool) error {
+	return s.SystemTasksSetSshContext(context.Background(), enable)
+}
+
+// SystemTasksSetSshContext - SystemTasksSetSsh with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemTasksSetSshContext(ctx context.Context, enable bool) error {
 	params := struct {
 		Enable bool `json:"enable"`
 	}{enable}
-	_, err := s.CallRaw("SystemTasks.setSsh", params)
+	_, err := s.CallRawContext(ctx, "SystemTasks.setSsh", params)
 	return err
 }
 
 // SystemTasksReboot - Performs system reboot
 func (s *ServerConnection) SystemTasksReboot() error {
-	_, err := s.CallRaw("SystemTasks.reboot", nil)
+	return s.SystemTasksRebootContext(context.Background())
+}
+
+// SystemTasksRebootContext - SystemTasksReboot with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemTasksRebootContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "SystemTasks.reboot", nil)
 	return err
 }
 
 // SystemTasksShutdown - Performs system shutdown
 func (s *ServerConnection) SystemTasksShutdown() error {
-	_, err := s.CallRaw("SystemTasks.shutdown", nil)
+	return s.SystemTasksShutdownContext(context.Background())
+}
+
+// SystemTasksShutdownContext - SystemTasksShutdown with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) SystemTasksShutdownContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "SystemTasks.shutdown", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // UserInfo - A contact to user
 type UserInfo struct {
@@ -33,7 +36,12 @@ type SystemInfo struct {
 //	systemInfo (out SystemInfo) System information
 //	isUploadServerAvailable (out boolean) Is possible to upload attachment ?
 func (s *ServerConnection) TechnicalSupportGetInfo() (*UserInfo, *ProductInfo, *SystemInfo, bool, error) {
-	data, err := s.CallRaw("TechnicalSupport.getInfo", nil)
+	return s.TechnicalSupportGetInfoContext(context.Background())
+}
+
+// TechnicalSupportGetInfoContext - TechnicalSupportGetInfo with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TechnicalSupportGetInfoContext(ctx context.Context) (*UserInfo, *ProductInfo, *SystemInfo, bool, error) {
+	data, err := s.CallRawContext(ctx, "TechnicalSupport.getInfo", nil)
 	if err != nil {
 		return nil, nil, nil, false, err
 	}
@@ -53,10 +61,15 @@ func (s *ServerConnection) TechnicalSupportGetInfo() (*UserInfo, *ProductInfo, *
 //	ticketId (in string) tickedId of target ticket
 //	email (in string) email of the customer
 func (s *ServerConnection) TechnicalSupportAddSystemInfoToTicket(ticketId string, email string) error {
+	return s.TechnicalSupportAddSystemInfoToTicketContext(context.Background(), ticketId, email)
+}
+
+// TechnicalSupportAddSystemInfoToTicketContext - TechnicalSupportAddSystemInfoToTicket with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TechnicalSupportAddSystemInfoToTicketContext(ctx context.Context, ticketId string, email string) error {
 	params := struct {
 		TicketId string `json:"ticketId"`
 		Email    string `json:"email"`
 	}{ticketId, email}
-	_, err := s.CallRaw("TechnicalSupport.addSystemInfoToTicket", params)
+	_, err := s.CallRawContext(ctx, "TechnicalSupport.addSystemInfoToTicket", params)
 	return err
 }
@@ -1,6 +1,10 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
 
 type Day string
 
@@ -16,11 +20,268 @@ const (
 
 type DayList []Day
 
+type TimeRangeType string
+
+const (
+	TimeRangeDaily      TimeRangeType = "TimeRangeDaily"      // every day from fromTime to toTime
+	TimeRangeWeekly     TimeRangeType = "TimeRangeWeekly"     // given days from fromTime to toTime
+	TimeRangeAbsolute   TimeRangeType = "TimeRangeAbsolute"   // from fromDate fromTime to toDate toTime
+	TimeRangeChildGroup TimeRangeType = "TimeRangeChildGroup" // another time range group
+)
+
+type TimeRangeGroup struct {
+	Id   KId    `json:"id"`
+	Name string `json:"name"`
+}
+
+type TimeRangeEntry struct {
+	Id          KId           `json:"id"`
+	GroupId     KId           `json:"groupId"`
+	SharedId    KId           `json:"sharedId"` // read-only; filled when the item is shared in MyKerio
+	GroupName   string        `json:"groupName"`
+	Description string        `json:"description"`
+	Type        TimeRangeType `json:"type"`
+	Enabled     bool          `json:"enabled"`
+	Status      StoreStatus   `json:"status"`
+	/*@{ daily, weekly, absolute */
+	FromTime Time `json:"fromTime"`
+	ToTime   Time `json:"toTime"`
+	/*@}*/
+	/*@{ weekly */
+	Days DayList `json:"days"`
+	/*@}*/
+	/*@{ absolute */
+	FromDate Date `json:"fromDate"`
+	ToDate   Date `json:"toDate"`
+	/*@}*/
+	/*@{ group */
+	ChildGroupId   KId    `json:"childGroupId"`
+	ChildGroupName string `json:"childGroupName"`
+	/*@}*/
+}
+
+type TimeRangeEntryList []TimeRangeEntry
+
+type TimeRangeGroupList []TimeRangeGroup
+
+var weekdays = map[time.Weekday]Day{
+	time.Monday:    Monday,
+	time.Tuesday:   Tuesday,
+	time.Wednesday: Wednesday,
+	time.Thursday:  Thursday,
+	time.Friday:    Friday,
+	time.Saturday:  Saturday,
+	time.Sunday:    Sunday,
+}
+
+// Contains reports whether t falls inside the entry. Entries of type TimeRangeChildGroup
+// are resolved by TimeRangeEntryList.Contains only, here they never match.
+// t should be in the time zone of the server. Ranges ending before they start (e.g. 22:00 - 06:00)
+// continue over midnight, ranges starting and ending at the same time cover the whole day.
+func (e *TimeRangeEntry) Contains(t time.Time) bool {
+	switch e.Type {
+	case TimeRangeDaily:
+		return timeOfDayIn(t, e.FromTime, e.ToTime)
+	case TimeRangeWeekly:
+		if afterMidnight(t, e.FromTime, e.ToTime) {
+			// the part after midnight belongs to the range started the previous day
+			return dayIn(t.AddDate(0, 0, -1), e.Days)
+		}
+		return dayIn(t, e.Days) && timeOfDayIn(t, e.FromTime, e.ToTime)
+	case TimeRangeAbsolute:
+		from := e.FromDate.at(e.FromTime, t.Location())
+		to := e.ToDate.at(e.ToTime, t.Location())
+		return !t.Before(from) && t.Before(to)
+	}
+	return false
+}
+
+// Contains reports whether t falls inside any enabled entry of the group groupId, the list must contain
+// entries of all groups referenced by the group (as returned by TimeRangesGet without conditions)
+func (l TimeRangeEntryList) Contains(groupId KId, t time.Time) bool {
+	return l.contains(groupId, t, map[KId]bool{})
+}
+
+func (l TimeRangeEntryList) contains(groupId KId, t time.Time, visited map[KId]bool) bool {
+	if visited[groupId] {
+		return false
+	}
+	visited[groupId] = true
+	for i := range l {
+		e := &l[i]
+		if e.GroupId != groupId || !e.Enabled {
+			continue
+		}
+		if e.Type == TimeRangeChildGroup {
+			if l.contains(e.ChildGroupId, t, visited) {
+				return true
+			}
+			continue
+		}
+		if e.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d Date) at(tm Time, loc *time.Location) time.Time {
+	return time.Date(d.Year, time.Month(d.Month+1), d.Day, tm.Hour, tm.Min, 0, 0, loc)
+}
+
+func dayIn(t time.Time, days DayList) bool {
+	for _, day := range days {
+		if day == weekdays[t.Weekday()] {
+			return true
+		}
+	}
+	return false
+}
+
+func timeOfDayIn(t time.Time, from, to Time) bool {
+	minute, start, end := minutes(t, from, to)
+	switch {
+	case start == end:
+		return true
+	case start < end:
+		return minute >= start && minute < end
+	default:
+		return minute >= start || minute < end
+	}
+}
+
+// afterMidnight reports whether t falls into the part after midnight of a range continuing over midnight
+func afterMidnight(t time.Time, from, to Time) bool {
+	minute, start, end := minutes(t, from, to)
+	return start > end && minute < end
+}
+
+// minutes returns minutes since midnight of t, from and to
+func minutes(t time.Time, from, to Time) (int, int, int) {
+	return t.Hour()*60 + t.Minute(), from.Hour*60 + from.Min, to.Hour*60 + to.Min
+}
+
+// TimeRangesGet - Get the list of time ranges
+//	query - conditions and limits
+// Return
+//	list - list of time ranges and it's details
+//	totalItems - count of all time ranges on server (before the start/limit applied)
+func (s *ServerConnection) TimeRangesGet(query SearchQuery) (TimeRangeEntryList, int, error) {
+	return s.TimeRangesGetContext(context.Background(), query)
+}
+
+// TimeRangesGetContext - TimeRangesGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesGetContext(ctx context.Context, query SearchQuery) (TimeRangeEntryList, int, error) {
+	query = addMissedParametersToSearchQuery(query)
+	params := struct {
+		Query SearchQuery `json:"query"`
+	}{query}
+	data, err := s.CallRawContext(ctx, "TimeRanges.get", params)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := struct {
+		Result struct {
+			List       TimeRangeEntryList `json:"list"`
+			TotalItems int                `json:"totalItems"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &list)
+	return list.Result.List, list.Result.TotalItems, err
+}
+
+// TimeRangesCreate - Add new time ranges
+//	ranges - details for new time ranges. field id is assigned by the manager to temporary value until apply() or reset().
+// Return
+//	errors - list of errors
+//	result - list of IDs assigned to each item
+func (s *ServerConnection) TimeRangesCreate(ranges TimeRangeEntryList) (ErrorList, CreateResultList, error) {
+	return s.TimeRangesCreateContext(context.Background(), ranges)
+}
+
+// TimeRangesCreateContext - TimeRangesCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesCreateContext(ctx context.Context, ranges TimeRangeEntryList) (ErrorList, CreateResultList, error) {
+	params := struct {
+		Ranges TimeRangeEntryList `json:"ranges"`
+	}{ranges}
+	data, err := s.CallRawContext(ctx, "TimeRanges.create", params)
+	if err != nil {
+		return nil, nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList        `json:"errors"`
+			Result CreateResultList `json:"result"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, errors.Result.Result, err
+}
+
+// TimeRangesSet - Update existing time ranges
+//	rangeIds - ids of time ranges to be updated.
+//	details - details for update. Field "kerio::web::KId" is ignored. All other fields must be filled and they are written to all time ranges specified by rangeIds.
+// Return
+//	errors - list of errors
+func (s *ServerConnection) TimeRangesSet(rangeIds StringList, details TimeRangeEntry) (ErrorList, error) {
+	return s.TimeRangesSetContext(context.Background(), rangeIds, details)
+}
+
+// TimeRangesSetContext - TimeRangesSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesSetContext(ctx context.Context, rangeIds StringList, details TimeRangeEntry) (ErrorList, error) {
+	params := struct {
+		RangeIds StringList     `json:"rangeIds"`
+		Details  TimeRangeEntry `json:"details"`
+	}{rangeIds, details}
+	data, err := s.CallRawContext(ctx, "TimeRanges.set", params)
+	if err != nil {
+		return nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList `json:"errors"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, err
+}
+
+// TimeRangesRemove - Remove time ranges
+//	rangeIds - ids of time ranges that should be removed
+// Return
+//	errors - list of errors
+func (s *ServerConnection) TimeRangesRemove(rangeIds StringList) (ErrorList, error) {
+	return s.TimeRangesRemoveContext(context.Background(), rangeIds)
+}
+
+// TimeRangesRemoveContext - TimeRangesRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesRemoveContext(ctx context.Context, rangeIds StringList) (ErrorList, error) {
+	params := struct {
+		RangeIds StringList `json:"rangeIds"`
+	}{rangeIds}
+	data, err := s.CallRawContext(ctx, "TimeRanges.remove", params)
+	if err != nil {
+		return nil, err
+	}
+	errors := struct {
+		Result struct {
+			Errors ErrorList `json:"errors"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &errors)
+	return errors.Result.Errors, err
+}
+
 // TimeRangesApply - Write changes cached in manager to configuration
 // Return
 //	errors - list of errors
 func (s *ServerConnection) TimeRangesApply() (ErrorList, error) {
-	data, err := s.CallRaw("TimeRanges.apply", nil)
+	return s.TimeRangesApplyContext(context.Background())
+}
+
+// TimeRangesApplyContext - TimeRangesApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "TimeRanges.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +296,31 @@ func (s *ServerConnection) TimeRangesApply() (ErrorList, error) {
 
 // TimeRangesReset - Discard changes cached in manager
 func (s *ServerConnection) TimeRangesReset() error {
-	_, err := s.CallRaw("TimeRanges.reset", nil)
+	return s.TimeRangesResetContext(context.Background())
+}
+
+// TimeRangesResetContext - TimeRangesReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "TimeRanges.reset", nil)
 	return err
 }
+
+// TimeRangesGetGroupList - Get the list of groups, sorted in asc order
+func (s *ServerConnection) TimeRangesGetGroupList() (TimeRangeGroupList, error) {
+	return s.TimeRangesGetGroupListContext(context.Background())
+}
+
+// TimeRangesGetGroupListContext - TimeRangesGetGroupList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TimeRangesGetGroupListContext(ctx context.Context) (TimeRangeGroupList, error) {
+	data, err := s.CallRawContext(ctx, "TimeRanges.getGroupList", nil)
+	if err != nil {
+		return nil, err
+	}
+	groups := struct {
+		Result struct {
+			Groups TimeRangeGroupList `json:"groups"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &groups)
+	return groups.Result.Groups, err
+}
@@ -0,0 +1,89 @@
+package control
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeRangeEntryContains(t *testing.T) {
+	// 2021-01-01 is Friday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2021, time.January, day, hour, min, 0, 0, time.UTC)
+	}
+	daily := TimeRangeEntry{Type: TimeRangeDaily, FromTime: Time{8, 0}, ToTime: Time{16, 30}}
+	dailyOvernight := TimeRangeEntry{Type: TimeRangeDaily, FromTime: Time{22, 0}, ToTime: Time{6, 0}}
+	wholeDay := TimeRangeEntry{Type: TimeRangeDaily, FromTime: Time{0, 0}, ToTime: Time{0, 0}}
+	weekly := TimeRangeEntry{Type: TimeRangeWeekly, FromTime: Time{8, 0}, ToTime: Time{16, 0}, Days: DayList{Monday, Friday}}
+	weeklyOvernight := TimeRangeEntry{Type: TimeRangeWeekly, FromTime: Time{22, 0}, ToTime: Time{6, 0}, Days: DayList{Friday}}
+	absolute := TimeRangeEntry{
+		Type:     TimeRangeAbsolute,
+		FromDate: Date{Year: 2021, Month: 0, Day: 1},
+		FromTime: Time{12, 0},
+		ToDate:   Date{Year: 2021, Month: 0, Day: 3},
+		ToTime:   Time{6, 0},
+	}
+	group := TimeRangeEntry{Type: TimeRangeChildGroup, ChildGroupId: "1"}
+	tests := []struct {
+		name     string
+		entry    TimeRangeEntry
+		t        time.Time
+		expected bool
+	}{
+		{"daily inside", daily, at(1, 12, 0), true},
+		{"daily start", daily, at(1, 8, 0), true},
+		{"daily end", daily, at(1, 16, 30), false},
+		{"daily before", daily, at(1, 7, 59), false},
+		{"daily overnight evening", dailyOvernight, at(1, 23, 0), true},
+		{"daily overnight morning", dailyOvernight, at(2, 5, 59), true},
+		{"daily overnight noon", dailyOvernight, at(2, 12, 0), false},
+		{"whole day", wholeDay, at(4, 3, 0), true},
+		{"weekly on day", weekly, at(4, 9, 0), true},
+		{"weekly on other day", weekly, at(5, 9, 0), false},
+		{"weekly on day outside", weekly, at(1, 17, 0), false},
+		{"weekly overnight start day", weeklyOvernight, at(1, 22, 0), true},
+		{"weekly overnight next morning", weeklyOvernight, at(2, 3, 0), true},
+		{"weekly overnight start day morning", weeklyOvernight, at(1, 3, 0), false},
+		{"weekly overnight next evening", weeklyOvernight, at(2, 23, 0), false},
+		{"weekly overnight end", weeklyOvernight, at(2, 6, 0), false},
+		{"absolute inside", absolute, at(2, 0, 0), true},
+		{"absolute start", absolute, at(1, 12, 0), true},
+		{"absolute before", absolute, at(1, 11, 59), false},
+		{"absolute end", absolute, at(3, 6, 0), false},
+		{"child group", group, at(1, 12, 0), false},
+	}
+	for _, test := range tests {
+		if got := test.entry.Contains(test.t); got != test.expected {
+			t.Errorf("%s: Contains(%s) = %v, expected %v", test.name, test.t.Format("Mon 15:04"), got, test.expected)
+		}
+	}
+}
+
+func TestTimeRangeEntryListContains(t *testing.T) {
+	list := TimeRangeEntryList{
+		{GroupId: "1", Enabled: true, Type: TimeRangeDaily, FromTime: Time{8, 0}, ToTime: Time{12, 0}},
+		{GroupId: "1", Enabled: true, Type: TimeRangeChildGroup, ChildGroupId: "2"},
+		{GroupId: "2", Enabled: true, Type: TimeRangeDaily, FromTime: Time{14, 0}, ToTime: Time{16, 0}},
+		{GroupId: "2", Enabled: false, Type: TimeRangeDaily, FromTime: Time{18, 0}, ToTime: Time{20, 0}},
+		{GroupId: "2", Enabled: true, Type: TimeRangeChildGroup, ChildGroupId: "1"},
+	}
+	at := func(hour int) time.Time {
+		return time.Date(2021, time.January, 1, hour, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		groupId  KId
+		hour     int
+		expected bool
+	}{
+		{"1", 9, true},
+		{"1", 15, true},
+		{"1", 19, false},
+		{"2", 9, true},
+		{"2", 13, false},
+		{"3", 9, false},
+	}
+	for _, test := range tests {
+		if got := list.Contains(test.groupId, at(test.hour)); got != test.expected {
+			t.Errorf("Contains(%s, %d:00) = %v, expected %v", test.groupId, test.hour, got, test.expected)
+		}
+	}
+}
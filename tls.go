@@ -0,0 +1,109 @@
+package control
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type tlsOptions struct {
+	config       *tls.Config
+	rootCAs      *x509.CertPool
+	clientCerts  []tls.Certificate
+	fingerprints [][]byte
+	insecure     bool
+}
+
+// SetTLSConfig sets the base TLS configuration used for connecting to the server.
+// Other TLS options of Config are applied on top of a copy of tlsConfig.
+func (c *Config) SetTLSConfig(tlsConfig *tls.Config) {
+	c.tls.config = tlsConfig
+}
+
+// AddRootCAs adds PEM encoded CA certificates trusted when verifying the server certificate
+//	pemCerts - CA bundle in PEM format
+func (c *Config) AddRootCAs(pemCerts []byte) error {
+	if c.tls.rootCAs == nil {
+		c.tls.rootCAs = x509.NewCertPool()
+	}
+	if !c.tls.rootCAs.AppendCertsFromPEM(pemCerts) {
+		return errors.New("no valid PEM certificates found")
+	}
+	return nil
+}
+
+// SetClientCertificate sets the certificate presented to the server
+func (c *Config) SetClientCertificate(cert tls.Certificate) {
+	c.tls.clientCerts = []tls.Certificate{cert}
+}
+
+// PinFingerprint trusts the server certificate with given SHA-256 fingerprint, even if it is self-signed.
+// When at least one fingerprint is pinned, certificates with other fingerprints are rejected.
+//	fingerprint - hexa values, optionally separated by colons, as in Certificate.FingerprintSha256
+func (c *Config) PinFingerprint(fingerprint string) error {
+	sum, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil {
+		return fmt.Errorf("invalid fingerprint %q: %w", fingerprint, err)
+	}
+	if len(sum) != sha256.Size {
+		return fmt.Errorf("invalid fingerprint %q: expected %d bytes, got %d", fingerprint, sha256.Size, len(sum))
+	}
+	c.tls.fingerprints = append(c.tls.fingerprints, sum)
+	return nil
+}
+
+// PinCertificate trusts the server certificate, e.g. obtained by CertificatesGet, by its SHA-256 fingerprint
+func (c *Config) PinCertificate(certificate *Certificate) error {
+	return c.PinFingerprint(certificate.FingerprintSha256)
+}
+
+// SetInsecure disables verification of the server certificate.
+// Use only for testing, the connection is then vulnerable to man-in-the-middle attacks.
+func (c *Config) SetInsecure(insecure bool) {
+	c.tls.insecure = insecure
+}
+
+// clientTLSConfig returns TLS configuration for the HTTP transport, nil if the defaults should be used
+func (c *Config) clientTLSConfig() *tls.Config {
+	o := c.tls
+	if o.config == nil && o.rootCAs == nil && o.clientCerts == nil && o.fingerprints == nil && !o.insecure {
+		return nil
+	}
+	config := &tls.Config{}
+	if o.config != nil {
+		config = o.config.Clone()
+	}
+	if o.rootCAs != nil {
+		config.RootCAs = o.rootCAs
+	}
+	if o.clientCerts != nil {
+		config.Certificates = o.clientCerts
+	}
+	if o.insecure {
+		config.InsecureSkipVerify = true
+	}
+	if o.fingerprints != nil {
+		// the chain is not verified, the pinned fingerprint replaces it;
+		// unlike VerifyPeerCertificate, VerifyConnection is called for resumed sessions as well
+		config.InsecureSkipVerify = true
+		fingerprints := o.fingerprints
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+			for _, fingerprint := range fingerprints {
+				if bytes.Equal(sum[:], fingerprint) {
+					return nil
+				}
+			}
+			return fmt.Errorf("server certificate fingerprint %x is not pinned", sum)
+		}
+	}
+	return config
+}
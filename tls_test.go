@@ -0,0 +1,104 @@
+package control_test
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/igiant/control"
+	"github.com/igiant/control/controltest"
+)
+
+// otherFingerprint - fingerprint not matching the certificate of fake servers, which all share one certificate
+var otherFingerprint = formatFingerprint(sha256.Sum256([]byte("other certificate")))
+
+// fingerprint returns SHA-256 fingerprint of the certificate of fake
+func fingerprint(t *testing.T, fake *controltest.Server) string {
+	t.Helper()
+	block, _ := pem.Decode(fake.Certificate())
+	if block == nil {
+		t.Fatal("no certificate of the fake server")
+	}
+	return formatFingerprint(sha256.Sum256(block.Bytes))
+}
+
+// formatFingerprint returns sum formatted as Certificate.FingerprintSha256
+func formatFingerprint(sum [sha256.Size]byte) string {
+	hexa := make([]string, len(sum))
+	for i, b := range sum {
+		hexa[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hexa, ":")
+}
+
+// loginTo logs in fake by config and returns the error
+func loginTo(config *control.Config) error {
+	conn, err := config.NewConnection()
+	if err != nil {
+		return err
+	}
+	return conn.Login(controltest.UserName, controltest.Password, nil)
+}
+
+func TestPinFingerprint(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+
+	config := control.NewConfig(fake.Addr())
+	if err := config.PinFingerprint(fingerprint(t, fake)); err != nil {
+		t.Fatal(err)
+	}
+	if err := loginTo(config); err != nil {
+		t.Errorf("got %v, expected the pinned certificate accepted", err)
+	}
+
+	config = control.NewConfig(fake.Addr())
+	if err := config.PinFingerprint(otherFingerprint); err != nil {
+		t.Fatal(err)
+	}
+	if err := loginTo(config); err == nil || !strings.Contains(err.Error(), "is not pinned") {
+		t.Errorf("got %v, expected a different certificate rejected", err)
+	}
+
+	config = control.NewConfig(fake.Addr())
+	if err := config.AddRootCAs(fake.Certificate()); err != nil {
+		t.Fatal(err)
+	}
+	if err := loginTo(config); err != nil {
+		t.Errorf("got %v, expected the certificate of the added CA accepted", err)
+	}
+
+	for _, invalid := range []string{"", "not hexa", "ab:cd"} {
+		if err := config.PinFingerprint(invalid); err == nil {
+			t.Errorf("%q: got no error", invalid)
+		}
+	}
+}
+
+func TestPinFingerprintResumedSession(t *testing.T) {
+	fake := controltest.NewServer()
+	defer fake.Close()
+	cache := tls.NewLRUClientSessionCache(4)
+
+	config := control.NewConfig(fake.Addr())
+	config.SetTLSConfig(&tls.Config{ClientSessionCache: cache})
+	if err := config.PinFingerprint(fingerprint(t, fake)); err != nil {
+		t.Fatal(err)
+	}
+	if err := loginTo(config); err != nil {
+		t.Fatal(err)
+	}
+
+	// the session cached by the first connection is resumed, the pin must be checked anyway
+	config = control.NewConfig(fake.Addr())
+	config.SetTLSConfig(&tls.Config{ClientSessionCache: cache})
+	if err := config.PinFingerprint(otherFingerprint); err != nil {
+		t.Fatal(err)
+	}
+	if err := loginTo(config); err == nil || !strings.Contains(err.Error(), "is not pinned") {
+		t.Errorf("got %v, expected the resumed session rejected", err)
+	}
+}
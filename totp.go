@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type TotpState string
 
@@ -15,11 +18,16 @@ const (
 //  code -
 //  remember -
 func (s *ServerConnection) TotpTotpVerify(code int, remember bool) error {
+	return s.TotpTotpVerifyContext(context.Background(), code, remember)
+}
+
+// TotpTotpVerifyContext - TotpTotpVerify with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TotpTotpVerifyContext(ctx context.Context, code int, remember bool) error {
 	params := struct {
 		Code     int  `json:"code"`
 		Remember bool `json:"remember"`
 	}{code, remember}
-	_, err := s.CallRaw("Totp.totpVerify", params)
+	_, err := s.CallRawContext(ctx, "Totp.totpVerify", params)
 	return err
 }
 
@@ -27,7 +35,12 @@ func (s *ServerConnection) TotpTotpVerify(code int, remember bool) error {
 // Return
 //  state -
 func (s *ServerConnection) TotpTotpState() (*TotpState, error) {
-	data, err := s.CallRaw("Totp.totpState", nil)
+	return s.TotpTotpStateContext(context.Background())
+}
+
+// TotpTotpStateContext - TotpTotpState with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TotpTotpStateContext(ctx context.Context) (*TotpState, error) {
+	data, err := s.CallRawContext(ctx, "Totp.totpState", nil)
 	if err != nil {
 		return nil, err
 	}
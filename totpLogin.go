@@ -0,0 +1,108 @@
+package control
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TotpCodeFunc returns the current code of the second factor, e.g. entered by the user or generated by TotpSecret
+type TotpCodeFunc func(ctx context.Context) (int, error)
+
+const (
+	totpPeriod = 30 // seconds of validity of a code
+	totpDigits = 6
+)
+
+// TotpSecret returns TotpCodeFunc generating codes from the shared secret by RFC 6238
+// (HMAC-SHA1, 6 digits, 30 seconds), as authenticator applications do
+//	secret - base32 encoded secret, as shown when TOTP is configured; spaces and padding are ignored
+func TotpSecret(secret string) (TotpCodeFunc, error) {
+	secret = strings.ToUpper(strings.TrimRight(strings.ReplaceAll(secret, " ", ""), "="))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid TOTP secret: empty")
+	}
+	return func(context.Context) (int, error) {
+		return totpCode(key, time.Now()), nil
+	}, nil
+}
+
+// totpCode returns the code of key valid at time t
+func totpCode(key []byte, t time.Time) int {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(t.Unix()/totpPeriod))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return int(value % modulo)
+}
+
+// LoginWithTOTP logs in userName and performs the 2 step verification, if it is required by the server
+//	userName - login name + domain name (can be omitted if primary/local) of the user to be logged in
+//	password - password of the user to be logged in
+//	application - client application description
+//	code - provider of the code of the second factor, see TotpSecret
+//	remember - whether the verification should be remembered by the server for the client
+func (s *ServerConnection) LoginWithTOTP(ctx context.Context, userName string, password string, application *ApiApplication, code TotpCodeFunc, remember bool) error {
+	if err := s.LoginContext(ctx, userName, password, application); err != nil {
+		return err
+	}
+	return s.verifyTOTP(ctx, code, remember)
+}
+
+// SetReloginTOTP makes automatic re-login, enabled by SetRelogin, perform also the 2 step verification by code.
+// It must be called after SetRelogin, which resets it; an error is returned if automatic re-login is not enabled.
+func (s *ServerConnection) SetReloginTOTP(code TotpCodeFunc) error {
+	if s.relogin == nil {
+		return errors.New("automatic re-login is not enabled, call SetRelogin first")
+	}
+	s.relogin.totp = code
+	return nil
+}
+
+// verifyTOTP performs the 2 step verification of the logged user, if the server requires it
+func (s *ServerConnection) verifyTOTP(ctx context.Context, code TotpCodeFunc, remember bool) error {
+	state, err := s.TotpTotpStateContext(ctx)
+	if err != nil {
+		return err
+	}
+	switch *state {
+	case TotpDone, TotpNotConfigured:
+		return nil
+	case TotpConfigure:
+		return fmt.Errorf("TOTP must be configured for the user in the administration first")
+	case TotpVerify:
+	default:
+		return fmt.Errorf("unknown TOTP state %q", *state)
+	}
+	value, err := code(ctx)
+	if err != nil {
+		return err
+	}
+	if err = s.TotpTotpVerifyContext(ctx, value, remember); err != nil {
+		return err
+	}
+	if state, err = s.TotpTotpStateContext(ctx); err != nil {
+		return err
+	}
+	if *state != TotpDone {
+		return fmt.Errorf("TOTP verification not finished, state %q", *state)
+	}
+	return nil
+}
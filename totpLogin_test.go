@@ -0,0 +1,66 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestTotpCode checks codes of the SHA1 test vectors of RFC 6238, appendix B, shortened to totpDigits
+func TestTotpCode(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		code int // 8 digits code of the RFC
+	}{
+		{59, 94287082},
+		{1111111109, 7081804},
+		{1111111111, 14050471},
+		{1234567890, 89005924},
+		{2000000000, 69279037},
+		{20000000000, 65353130},
+	}
+	for _, test := range tests {
+		if got, expected := totpCode(key, time.Unix(test.unix, 0)), test.code%1000000; got != expected {
+			t.Errorf("%d: got %06d, expected %06d", test.unix, got, expected)
+		}
+	}
+}
+
+func TestTotpSecret(t *testing.T) {
+	// base32 of the key of RFC 6238, lower case with spaces and padding as shown by some applications
+	code, err := TotpSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq====")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("12345678901234567890")
+	before := totpCode(key, time.Now())
+	got, err := code(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the period may end meanwhile
+	if after := totpCode(key, time.Now()); got != before && got != after {
+		t.Errorf("got %06d, expected %06d", got, after)
+	}
+	for _, secret := range []string{"", "====", "not base32!"} {
+		if _, err = TotpSecret(secret); err == nil {
+			t.Errorf("%q: got no error", secret)
+		}
+	}
+}
+
+func TestSetReloginTOTP(t *testing.T) {
+	s := &ServerConnection{Config: NewConfig("localhost")}
+	code := func(context.Context) (int, error) { return 0, nil }
+	if err := s.SetReloginTOTP(code); err == nil {
+		t.Error("got no error before SetRelogin")
+	}
+	s.SetRelogin(StaticCredentials("admin", "s3cret"), nil)
+	if err := s.SetReloginTOTP(code); err != nil {
+		t.Fatal(err)
+	}
+	if s.relogin.totp == nil {
+		t.Error("TOTP of re-login not set")
+	}
+}
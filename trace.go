@@ -0,0 +1,73 @@
+package control
+
+import (
+	"context"
+	"net/url"
+)
+
+// Tracer starts spans of calls, e.g. package otelcontrol implements it by OpenTelemetry
+type Tracer interface {
+	// Start starts the span of the call of method, the returned context is passed down the middleware chain
+	Start(ctx context.Context, method string) (context.Context, Span)
+}
+
+// Span - span of one call started by Tracer
+type Span interface {
+	// End finishes the span with the outcome of the call
+	End(info CallInfo)
+}
+
+// CallInfo - outcome of a call
+type CallInfo struct {
+	Host         string // host of the server with port
+	ID           int    // request id of the last request sent for the call, 0 if no request was sent
+	ResponseSize int    // size of the raw response in bytes, including responses reporting errors
+	Err          error  // error of the call, *APIError if reported by the server
+}
+
+// callInfoKey - key of *CallInfo in the context of a traced call, filled in by call
+type callInfoKey struct{}
+
+// SetTracer enables tracing of calls of the connection by tracer: every call sent by CallRaw,
+// including the calls of methods like UsersGet and the batched ones, becomes a span.
+// Passing nil tracer disables tracing. SetTracer must not be called concurrently with calls.
+func (s *ServerConnection) SetTracer(tracer Tracer) {
+	s.tracer = tracer
+}
+
+// traceCall calls rt within the span started by the tracer of the connection
+func (s *ServerConnection) traceCall(ctx context.Context, rt RoundTripper, method string, params interface{}) ([]byte, error) {
+	ctx, span := s.tracer.Start(ctx, method)
+	info := &CallInfo{Host: s.Config.host()}
+	data, err := rt.RoundTrip(context.WithValue(ctx, callInfoKey{}, info), method, params)
+	if len(data) > 0 {
+		// e.g. answered by a middleware
+		info.ResponseSize = len(data)
+	}
+	info.Err = err
+	span.End(*info)
+	return data, err
+}
+
+// setCallID records id of the request sent for the traced call in ctx
+func setCallID(ctx context.Context, id int) {
+	if info, ok := ctx.Value(callInfoKey{}).(*CallInfo); ok {
+		info.ID = id
+	}
+}
+
+// setCallResponseSize records size of the response received for the traced call in ctx
+func setCallResponseSize(ctx context.Context, size int) {
+	if info, ok := ctx.Value(callInfoKey{}).(*CallInfo); ok {
+		info.ResponseSize = size
+	}
+}
+
+// host returns host of the server with port
+func (c *Config) host() string {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
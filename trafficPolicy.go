@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // SourceNatMode - Mode of source address NAT
 type SourceNatMode string
@@ -195,7 +198,12 @@ type TrafficPolicyFilter struct {
 //	list - list of Traffic Policy rules
 //	totalItems - count of all rules in Traffic Policy
 func (s *ServerConnection) TrafficPolicyGet() (TrafficRuleList, int, error) {
-	data, err := s.CallRaw("TrafficPolicy.get", nil)
+	return s.TrafficPolicyGetContext(context.Background())
+}
+
+// TrafficPolicyGetContext - TrafficPolicyGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicyGetContext(ctx context.Context) (TrafficRuleList, int, error) {
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.get", nil)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -215,11 +223,16 @@ func (s *ServerConnection) TrafficPolicyGet() (TrafficRuleList, int, error) {
 // Return
 //	errors - list of errors
 func (s *ServerConnection) TrafficPolicySet(rules TrafficRuleList, defaultRule TrafficRule) (ErrorList, error) {
+	return s.TrafficPolicySetContext(context.Background(), rules, defaultRule)
+}
+
+// TrafficPolicySetContext - TrafficPolicySet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicySetContext(ctx context.Context, rules TrafficRuleList, defaultRule TrafficRule) (ErrorList, error) {
 	params := struct {
 		Rules       TrafficRuleList `json:"rules"`
 		DefaultRule TrafficRule     `json:"defaultRule"`
 	}{rules, defaultRule}
-	data, err := s.CallRaw("TrafficPolicy.set", params)
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +247,12 @@ func (s *ServerConnection) TrafficPolicySet(rules TrafficRuleList, defaultRule T
 
 // TrafficPolicyGetCollisions - Return list of overlapped rules
 func (s *ServerConnection) TrafficPolicyGetCollisions() (CollisionList, error) {
-	data, err := s.CallRaw("TrafficPolicy.getCollisions", nil)
+	return s.TrafficPolicyGetCollisionsContext(context.Background())
+}
+
+// TrafficPolicyGetCollisionsContext - TrafficPolicyGetCollisions with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicyGetCollisionsContext(ctx context.Context) (CollisionList, error) {
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.getCollisions", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +269,12 @@ func (s *ServerConnection) TrafficPolicyGetCollisions() (CollisionList, error) {
 // Return
 //	rule - properties of default rule
 func (s *ServerConnection) TrafficPolicyGetDefaultRule() (*TrafficRule, error) {
-	data, err := s.CallRaw("TrafficPolicy.getDefaultRule", nil)
+	return s.TrafficPolicyGetDefaultRuleContext(context.Background())
+}
+
+// TrafficPolicyGetDefaultRuleContext - TrafficPolicyGetDefaultRule with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicyGetDefaultRuleContext(ctx context.Context) (*TrafficRule, error) {
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.getDefaultRule", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -267,10 +290,15 @@ func (s *ServerConnection) TrafficPolicyGetDefaultRule() (*TrafficRule, error) {
 // TrafficPolicyFilterRules - Return all rules, that matches given criteria in time, when this method was called.
 //	condition - Filter parameters. Empty parameter (0 for numbers) in condition means 'any'.
 func (s *ServerConnection) TrafficPolicyFilterRules(condition TrafficPolicyFilter) (KIdList, error) {
+	return s.TrafficPolicyFilterRulesContext(context.Background(), condition)
+}
+
+// TrafficPolicyFilterRulesContext - TrafficPolicyFilterRules with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicyFilterRulesContext(ctx context.Context, condition TrafficPolicyFilter) (KIdList, error) {
 	params := struct {
 		Condition TrafficPolicyFilter `json:"condition"`
 	}{condition}
-	data, err := s.CallRaw("TrafficPolicy.filterRules", params)
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.filterRules", params)
 	if err != nil {
 		return nil, err
 	}
@@ -286,10 +314,15 @@ func (s *ServerConnection) TrafficPolicyFilterRules(condition TrafficPolicyFilte
 // TrafficPolicyNormalizeTrafficEntity - Normalize TrafficEntity
 //	input - TrafficEntity
 func (s *ServerConnection) TrafficPolicyNormalizeTrafficEntity(input TrafficEntity) (ErrorList, *TrafficEntity, error) {
+	return s.TrafficPolicyNormalizeTrafficEntityContext(context.Background(), input)
+}
+
+// TrafficPolicyNormalizeTrafficEntityContext - TrafficPolicyNormalizeTrafficEntity with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficPolicyNormalizeTrafficEntityContext(ctx context.Context, input TrafficEntity) (ErrorList, *TrafficEntity, error) {
 	params := struct {
 		Input TrafficEntity `json:"input"`
 	}{input}
-	data, err := s.CallRaw("TrafficPolicy.normalizeTrafficEntity", params)
+	data, err := s.CallRawContext(ctx, "TrafficPolicy.normalizeTrafficEntity", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type TrafficStatisticsType string
 
@@ -28,12 +31,17 @@ type TrafficStatisticList []TrafficStatistic
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) TrafficStatisticsGet(query SearchQuery, refresh bool) (TrafficStatisticList, int, error) {
+	return s.TrafficStatisticsGetContext(context.Background(), query, refresh)
+}
+
+// TrafficStatisticsGetContext - TrafficStatisticsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficStatisticsGetContext(ctx context.Context, query SearchQuery, refresh bool) (TrafficStatisticList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query   SearchQuery `json:"query"`
 		Refresh bool        `json:"refresh"`
 	}{query, refresh}
-	data, err := s.CallRaw("TrafficStatistics.get", params)
+	data, err := s.CallRawContext(ctx, "TrafficStatistics.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -50,10 +58,15 @@ func (s *ServerConnection) TrafficStatisticsGet(query SearchQuery, refresh bool)
 // TrafficStatisticsRemove - Deletes rule traffic counters
 //	ids - Ids of statistics items to be removed.
 func (s *ServerConnection) TrafficStatisticsRemove(ids KIdList) error {
+	return s.TrafficStatisticsRemoveContext(context.Background(), ids)
+}
+
+// TrafficStatisticsRemoveContext - TrafficStatisticsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficStatisticsRemoveContext(ctx context.Context, ids KIdList) error {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	_, err := s.CallRaw("TrafficStatistics.remove", params)
+	_, err := s.CallRawContext(ctx, "TrafficStatistics.remove", params)
 	return err
 }
 
@@ -62,11 +75,16 @@ func (s *ServerConnection) TrafficStatisticsRemove(ids KIdList) error {
 // Return
 //	hist - output data
 func (s *ServerConnection) TrafficStatisticsGetHistogram(histogramType HistogramType, id KId) (*Histogram, error) {
+	return s.TrafficStatisticsGetHistogramContext(context.Background(), histogramType, id)
+}
+
+// TrafficStatisticsGetHistogramContext - TrafficStatisticsGetHistogram with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficStatisticsGetHistogramContext(ctx context.Context, histogramType HistogramType, id KId) (*Histogram, error) {
 	params := struct {
 		HistogramType HistogramType `json:"histogramType"`
 		Id            KId           `json:"id"`
 	}{histogramType, id}
-	data, err := s.CallRaw("TrafficStatistics.getHistogram", params)
+	data, err := s.CallRawContext(ctx, "TrafficStatistics.getHistogram", params)
 	if err != nil {
 		return nil, err
 	}
@@ -86,12 +104,17 @@ func (s *ServerConnection) TrafficStatisticsGetHistogram(histogramType Histogram
 //	hist - output data
 //	sampleTime - Returns first time, that is not included in returned data (pass it to the same method again as a lastSampleTime to obtain only new data since last request)
 func (s *ServerConnection) TrafficStatisticsGetHistogramInc(histogramIntervalType HistogramIntervalType, id KId, startSampleTime DateTimeStamp) (*Histogram, *DateTimeStamp, error) {
+	return s.TrafficStatisticsGetHistogramIncContext(context.Background(), histogramIntervalType, id, startSampleTime)
+}
+
+// TrafficStatisticsGetHistogramIncContext - TrafficStatisticsGetHistogramInc with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) TrafficStatisticsGetHistogramIncContext(ctx context.Context, histogramIntervalType HistogramIntervalType, id KId, startSampleTime DateTimeStamp) (*Histogram, *DateTimeStamp, error) {
 	params := struct {
 		HistogramIntervalType HistogramIntervalType `json:"histogramIntervalType"`
 		Id                    KId                   `json:"id"`
 		StartSampleTime       DateTimeStamp         `json:"startSampleTime"`
 	}{histogramIntervalType, id, startSampleTime}
-	data, err := s.CallRaw("TrafficStatistics.getHistogramInc", params)
+	data, err := s.CallRawContext(ctx, "TrafficStatistics.getHistogramInc", params)
 	if err != nil {
 		return nil, nil, err
 	}
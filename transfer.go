@@ -0,0 +1,181 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+const uploadPath = "/upload"
+
+// Methods of transfers reported to Metrics, they are not methods of the API
+const (
+	UploadMethod   = "upload"   // Upload
+	DownloadMethod = "download" // Fetch and FetchProgress
+)
+
+// Upload - Upload file to the server. Returned id is passed as fileId to methods importing the file,
+// e.g. CertificatesImportCertificateP12, DnsImportHosts or UpdateCheckerUploadImage.
+// Content of r is streamed, it is not read into memory.
+//	name - file name
+//	r - content of the file
+// Return
+//	fileId - id of uploaded file
+func (s *ServerConnection) Upload(ctx context.Context, name string, r io.Reader) (string, error) {
+	release, err := s.Config.acquire(ctx, "")
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	start := time.Now()
+	pipe, writer := io.Pipe()
+	body := &countingReader{PipeReader: pipe}
+	form := multipart.NewWriter(writer)
+	go func() {
+		part, err := form.CreateFormFile("newFile", name)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	fileId, responseSize, err := s.upload(ctx, name, body, form.FormDataContentType())
+	s.observe(UploadMethod, start, int(atomic.LoadInt64(&body.n)), responseSize, err)
+	return fileId, err
+}
+
+// upload sends the multipart body of Upload, it returns the file id and size of the response
+func (s *ServerConnection) upload(ctx context.Context, name string, body *countingReader, contentType string) (string, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.Config.url+uploadPath, body)
+	if err != nil {
+		_ = body.Close()
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if token := s.token(); token != nil {
+		req.Header.Add("X-Token", *token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		_ = body.CloseWithError(err)
+		return "", 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", len(data), err
+	}
+	if err = checkError(data); err != nil {
+		return "", len(data), err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", len(data), fmt.Errorf("upload of %s failed: %s", name, resp.Status)
+	}
+	fileId := struct {
+		Result struct {
+			Id string `json:"id"`
+		} `json:"result"`
+	}{}
+	err = json.Unmarshal(data, &fileId)
+	if err == nil && fileId.Result.Id == "" {
+		err = fmt.Errorf("upload of %s failed: server returned no file id", name)
+	}
+	return fileId.Result.Id, len(data), err
+}
+
+// countingReader counts bytes read from the body of a request
+type countingReader struct {
+	n int64 // accessed atomically, first for alignment
+	*io.PipeReader
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.PipeReader.Read(p)
+	atomic.AddInt64(&c.n, int64(n))
+	return n, err
+}
+
+// ProgressFunc is called during the download with count of bytes written so far and expected total size
+type ProgressFunc func(written, total int64)
+
+// Fetch - Download file described by download, e.g. returned by LogsExportLog or ConfigurationExportConfig, to w.
+// Session of the connection and its TLS settings are used.
+// Return
+//	written - count of bytes written to w
+func (s *ServerConnection) Fetch(ctx context.Context, download *Download, w io.Writer) (int64, error) {
+	return s.FetchProgress(ctx, download, w, nil)
+}
+
+// FetchProgress - Fetch reporting the progress of the download to progress, which may be nil
+func (s *ServerConnection) FetchProgress(ctx context.Context, download *Download, w io.Writer, progress ProgressFunc) (int64, error) {
+	release, err := s.Config.acquire(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+	start := time.Now()
+	written, err := s.fetch(ctx, download, w, progress)
+	s.observe(DownloadMethod, start, 0, int(written), err)
+	return written, err
+}
+
+// fetch downloads the file of FetchProgress
+func (s *ServerConnection) fetch(ctx context.Context, download *Download, w io.Writer, progress ProgressFunc) (int64, error) {
+	base, err := url.Parse(s.Config.url)
+	if err != nil {
+		return 0, err
+	}
+	ref, err := url.Parse(download.Url)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", base.ResolveReference(ref).String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	if token := s.token(); token != nil {
+		req.Header.Add("X-Token", *token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("download of %s failed: %s", download.Name, resp.Status)
+	}
+	if progress != nil {
+		w = &progressWriter{w: w, total: int64(download.Length), progress: progress}
+	}
+	written, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return written, err
+	}
+	if download.Length > 0 && written != int64(download.Length) {
+		return written, fmt.Errorf("download of %s incomplete: got %d of %d bytes", download.Name, written, download.Length)
+	}
+	return written, nil
+}
+
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.progress(p.written, p.total)
+	return n, err
+}
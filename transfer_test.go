@@ -0,0 +1,187 @@
+package control_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/igiant/control"
+)
+
+const (
+	transferToken  = "t0ken"
+	transferCookie = "c00kie"
+)
+
+// transferServer - server of login, uploads and downloads checking the session of requests
+type transferServer struct {
+	srv      *httptest.Server
+	mu       sync.Mutex
+	noFileId bool   // upload responds no file id
+	content  []byte // content of downloads
+	// last upload
+	field, fileName string
+	uploaded        []byte
+}
+
+func newTransferServer(t *testing.T) (*transferServer, *control.ServerConnection) {
+	s := &transferServer{}
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.srv.Close)
+	config := control.NewConfig(strings.TrimPrefix(s.srv.URL, "https://"))
+	config.SetHTTPClient(s.srv.Client())
+	conn, err := config.NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.Login("admin", "s3cret", nil); err != nil {
+		t.Fatal(err)
+	}
+	return s, conn
+}
+
+func (s *transferServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/admin/api/jsonrpc" {
+		http.SetCookie(w, &http.Cookie{Name: "SESSION_CONTROL_WEBADMIN", Value: transferCookie, Path: "/"})
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"token":"` + transferToken + `"}}`))
+		return
+	}
+	cookie, err := r.Cookie("SESSION_CONTROL_WEBADMIN")
+	if r.Header.Get("X-Token") != transferToken || err != nil || cookie.Value != transferCookie {
+		http.Error(w, "session expired", http.StatusForbidden)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/admin/api/jsonrpc/upload":
+		reader, err := r.MultipartReader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		part, err := reader.NextPart()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.field, s.fileName = part.FormName(), part.FileName()
+		s.uploaded, _ = ioutil.ReadAll(part)
+		if s.noFileId {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"id":"upload-1"}}`))
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/download/"):
+		_, _ = w.Write(s.content)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	server, conn := newTransferServer(t)
+	fileId, err := conn.Upload(context.Background(), "hosts.txt", strings.NewReader("10.0.0.1 gw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fileId != "upload-1" {
+		t.Errorf("got file id %q", fileId)
+	}
+	if server.field != "newFile" || server.fileName != "hosts.txt" || string(server.uploaded) != "10.0.0.1 gw" {
+		t.Errorf("got field %q, file %q and content %q", server.field, server.fileName, server.uploaded)
+	}
+
+	server.noFileId = true
+	if _, err = conn.Upload(context.Background(), "hosts.txt", strings.NewReader("")); err == nil ||
+		!strings.Contains(err.Error(), "no file id") {
+		t.Errorf("got %v, expected missing file id reported", err)
+	}
+}
+
+func TestUploadWithoutSession(t *testing.T) {
+	server, _ := newTransferServer(t)
+	config := control.NewConfig(strings.TrimPrefix(server.srv.URL, "https://"))
+	config.SetHTTPClient(server.srv.Client())
+	conn, err := config.NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Upload(context.Background(), "hosts.txt", strings.NewReader("")); err == nil ||
+		!strings.Contains(err.Error(), "403") {
+		t.Errorf("got %v, expected the upload without the token and cookie rejected", err)
+	}
+}
+
+func TestFetchProgress(t *testing.T) {
+	server, conn := newTransferServer(t)
+	server.content = bytes.Repeat([]byte("0123456789"), 10000)
+	download := &control.Download{Url: "/download/log.txt", Name: "log.txt", Length: len(server.content)}
+	calls, decreasing := 0, false
+	var written, total int64
+	w := &bytes.Buffer{}
+	n, err := conn.FetchProgress(context.Background(), download, w, func(w, t int64) {
+		calls++
+		decreasing = decreasing || w < written
+		written, total = w, t
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(server.content)) || !bytes.Equal(w.Bytes(), server.content) {
+		t.Errorf("got %d bytes, expected %d", n, len(server.content))
+	}
+	if calls < 2 || decreasing || written != n || total != n {
+		t.Errorf("got %d progress calls up to %d of %d, expected growing progress up to %d", calls, written, total, n)
+	}
+
+	// the server sends less than announced
+	download.Length += 10
+	if _, err = conn.Fetch(context.Background(), download, ioutil.Discard); err == nil || !strings.Contains(err.Error(), "incomplete") {
+		t.Errorf("got %v, expected the incomplete download reported", err)
+	}
+}
+
+// observedCalls - Metrics recording observed calls
+type observedCalls struct {
+	mu    sync.Mutex
+	calls []control.CallMetrics
+}
+
+func (o *observedCalls) ObserveCall(call control.CallMetrics) {
+	o.mu.Lock()
+	o.calls = append(o.calls, call)
+	o.mu.Unlock()
+}
+
+func (o *observedCalls) ObserveRelogin(string) {}
+
+func TestTransferMetrics(t *testing.T) {
+	server, conn := newTransferServer(t)
+	observed := &observedCalls{}
+	conn.SetMetrics(observed)
+	content := strings.Repeat("10.0.0.1 gw\n", 1000)
+	if _, err := conn.Upload(context.Background(), "hosts.txt", strings.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	server.content = []byte(content)
+	download := &control.Download{Url: "/download/hosts.txt", Name: "hosts.txt", Length: len(content)}
+	if _, err := conn.Fetch(context.Background(), download, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if len(observed.calls) != 2 {
+		t.Fatalf("got %d observed calls, expected 2", len(observed.calls))
+	}
+	upload, fetch := observed.calls[0], observed.calls[1]
+	if upload.Method != control.UploadMethod || upload.RequestSize <= len(content) || upload.ResponseSize == 0 || upload.Err != nil {
+		t.Errorf("got upload %+v, expected the multipart body of more than %d bytes", upload, len(content))
+	}
+	if fetch.Method != control.DownloadMethod || fetch.RequestSize != 0 || fetch.ResponseSize != len(content) || fetch.Err != nil {
+		t.Errorf("got download %+v, expected %d bytes received", fetch, len(content))
+	}
+}
@@ -0,0 +1,110 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type transportOptions struct {
+	client          *http.Client
+	timeout         time.Duration
+	methodTimeouts  map[string]time.Duration
+	proxy           *url.URL
+	dialContext     func(ctx context.Context, network, address string) (net.Conn, error)
+	maxIdleConns    int
+	idleConnTimeout time.Duration
+}
+
+// SetHTTPClient sets the HTTP client used for connecting to the server. Other transport and TLS options
+// of Config are not applied to it, timeouts set by SetTimeout and SetMethodTimeout are.
+// Every connection uses a copy of client with its own cookie jar, unless client.Jar is set.
+func (c *Config) SetHTTPClient(client *http.Client) {
+	c.transport.client = client
+}
+
+// SetTimeout sets the maximal duration of requests to the server, including reading of the response.
+// Zero timeout, the default, means no timeout. Uploads and downloads are limited only by their contexts.
+func (c *Config) SetTimeout(timeout time.Duration) {
+	c.transport.timeout = timeout
+}
+
+// SetMethodTimeout sets the maximal duration of requests of method, overriding the timeout set by SetTimeout.
+//	method - method of the API, e.g. "Logs.get"
+//	timeout - the timeout, zero means no timeout
+func (c *Config) SetMethodTimeout(method string, timeout time.Duration) {
+	if c.transport.methodTimeouts == nil {
+		c.transport.methodTimeouts = map[string]time.Duration{}
+	}
+	c.transport.methodTimeouts[method] = timeout
+}
+
+// SetProxy sets the proxy the server is connected through, instead of the proxy set by environment variables
+//	proxy - URL of HTTP, HTTPS or SOCKS5 proxy, e.g. "socks5://jump.example.com:1080"
+func (c *Config) SetProxy(proxy string) error {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return fmt.Errorf("invalid proxy %q: %w", proxy, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("invalid proxy %q: unsupported scheme %q", proxy, u.Scheme)
+	}
+	c.transport.proxy = u
+	return nil
+}
+
+// SetDialContext sets the function creating network connections to the server (or to the proxy)
+func (c *Config) SetDialContext(dial func(ctx context.Context, network, address string) (net.Conn, error)) {
+	c.transport.dialContext = dial
+}
+
+// SetIdleConnections tunes keeping of idle (keep-alive) connections to the server
+//	maxIdle - maximal count of idle connections, zero keeps the default, negative disables keep-alive
+//	idleTimeout - how long an idle connection is kept, zero keeps the default
+func (c *Config) SetIdleConnections(maxIdle int, idleTimeout time.Duration) {
+	c.transport.maxIdleConns = maxIdle
+	c.transport.idleConnTimeout = idleTimeout
+}
+
+// customized reports whether the default HTTP transport must be modified
+func (o *transportOptions) customized() bool {
+	return o.proxy != nil || o.dialContext != nil || o.maxIdleConns != 0 || o.idleConnTimeout != 0
+}
+
+// apply sets the options to transport
+func (o *transportOptions) apply(transport *http.Transport) {
+	if o.proxy != nil {
+		transport.Proxy = http.ProxyURL(o.proxy)
+	}
+	if o.dialContext != nil {
+		transport.DialContext = o.dialContext
+	}
+	switch {
+	case o.maxIdleConns < 0:
+		transport.DisableKeepAlives = true
+	case o.maxIdleConns > 0:
+		transport.MaxIdleConns = o.maxIdleConns
+		transport.MaxIdleConnsPerHost = o.maxIdleConns
+	}
+	if o.idleConnTimeout != 0 {
+		transport.IdleConnTimeout = o.idleConnTimeout
+	}
+}
+
+// withTimeout returns ctx limited by the timeout of requests of method, if any.
+// Empty method stands for requests not bound to a method (batches).
+func (c *Config) withTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	timeout, ok := c.transport.methodTimeouts[method]
+	if !ok {
+		timeout = c.transport.timeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type SignOn struct {
 	IsEnabled bool   `json:"isEnabled"`
@@ -13,7 +16,12 @@ type SignOn struct {
 // Return
 //	settings - Sign On settings
 func (s *ServerConnection) UnitySignOnGet() (*SignOn, error) {
-	data, err := s.CallRaw("UnitySignOn.get", nil)
+	return s.UnitySignOnGetContext(context.Background())
+}
+
+// UnitySignOnGetContext - UnitySignOnGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UnitySignOnGetContext(ctx context.Context) (*SignOn, error) {
+	data, err := s.CallRawContext(ctx, "UnitySignOn.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +37,15 @@ func (s *ServerConnection) UnitySignOnGet() (*SignOn, error) {
 // UnitySignOnSet - Set Kerio Unity Sign On settings
 //	settings - Sign On settings
 func (s *ServerConnection) UnitySignOnSet(settings SignOn) error {
+	return s.UnitySignOnSetContext(context.Background(), settings)
+}
+
+// UnitySignOnSetContext - UnitySignOnSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UnitySignOnSetContext(ctx context.Context, settings SignOn) error {
 	params := struct {
 		Settings SignOn `json:"settings"`
 	}{settings}
-	_, err := s.CallRaw("UnitySignOn.set", params)
+	_, err := s.CallRawContext(ctx, "UnitySignOn.set", params)
 	return err
 }
 
@@ -42,11 +55,16 @@ func (s *ServerConnection) UnitySignOnSet(settings SignOn) error {
 // Return
 //	errors - error messages list; If no error is listed, connection is successful
 func (s *ServerConnection) UnitySignOnTestConnection(hostNames StringList, credentials Credentials) (ErrorList, error) {
+	return s.UnitySignOnTestConnectionContext(context.Background(), hostNames, credentials)
+}
+
+// UnitySignOnTestConnectionContext - UnitySignOnTestConnection with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UnitySignOnTestConnectionContext(ctx context.Context, hostNames StringList, credentials Credentials) (ErrorList, error) {
 	params := struct {
 		HostNames   StringList  `json:"hostNames"`
 		Credentials Credentials `json:"credentials"`
 	}{hostNames, credentials}
-	data, err := s.CallRaw("UnitySignOn.testConnection", params)
+	data, err := s.CallRawContext(ctx, "UnitySignOn.testConnection", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UpdateCheckerConfig struct {
 	Enabled             bool                `json:"enabled"`
@@ -49,7 +52,12 @@ type UpdateCheckerInfo struct {
 // Return
 //	config - Contains Structure with update checker's settings.
 func (s *ServerConnection) UpdateCheckerGet() (*UpdateCheckerConfig, error) {
-	data, err := s.CallRaw("UpdateChecker.get", nil)
+	return s.UpdateCheckerGetContext(context.Background())
+}
+
+// UpdateCheckerGetContext - UpdateCheckerGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerGetContext(ctx context.Context) (*UpdateCheckerConfig, error) {
+	data, err := s.CallRawContext(ctx, "UpdateChecker.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,19 +73,29 @@ func (s *ServerConnection) UpdateCheckerGet() (*UpdateCheckerConfig, error) {
 // UpdateCheckerSet - Stores configuration
 //	config - Contains Structure with update checker's settings to be stored &a pplied.
 func (s *ServerConnection) UpdateCheckerSet(config UpdateCheckerConfig) error {
+	return s.UpdateCheckerSetContext(context.Background(), config)
+}
+
+// UpdateCheckerSetContext - UpdateCheckerSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerSetContext(ctx context.Context, config UpdateCheckerConfig) error {
 	params := struct {
 		Config UpdateCheckerConfig `json:"config"`
 	}{config}
-	_, err := s.CallRaw("UpdateChecker.set", params)
+	_, err := s.CallRawContext(ctx, "UpdateChecker.set", params)
 	return err
 }
 
 // UpdateCheckerCheck - Checks for a new version
 func (s *ServerConnection) UpdateCheckerCheck(checkVersionType CheckVersionType) error {
+	return s.UpdateCheckerCheckContext(context.Background(), checkVersionType)
+}
+
+// UpdateCheckerCheckContext - UpdateCheckerCheck with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerCheckContext(ctx context.Context, checkVersionType CheckVersionType) error {
 	params := struct {
 		CheckVersionType CheckVersionType `json:"checkVersionType"`
 	}{checkVersionType}
-	_, err := s.CallRaw("UpdateChecker.check", params)
+	_, err := s.CallRawContext(ctx, "UpdateChecker.check", params)
 	return err
 }
 
@@ -85,7 +103,12 @@ func (s *ServerConnection) UpdateCheckerCheck(checkVersionType CheckVersionType)
 // Return
 //	status - a phase of update process.
 func (s *ServerConnection) UpdateCheckerGetStatus() (*UpdateCheckerInfo, error) {
-	data, err := s.CallRaw("UpdateChecker.getStatus", nil)
+	return s.UpdateCheckerGetStatusContext(context.Background())
+}
+
+// UpdateCheckerGetStatusContext - UpdateCheckerGetStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerGetStatusContext(ctx context.Context) (*UpdateCheckerInfo, error) {
+	data, err := s.CallRawContext(ctx, "UpdateChecker.getStatus", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +126,12 @@ func (s *ServerConnection) UpdateCheckerGetStatus() (*UpdateCheckerInfo, error)
 // Return
 //	percentage - Returns percentage progress for Status Downloading
 func (s *ServerConnection) UpdateCheckerGetProgressStatus() (int, error) {
-	data, err := s.CallRaw("UpdateChecker.getProgressStatus", nil)
+	return s.UpdateCheckerGetProgressStatusContext(context.Background())
+}
+
+// UpdateCheckerGetProgressStatusContext - UpdateCheckerGetProgressStatus with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerGetProgressStatusContext(ctx context.Context) (int, error) {
+	data, err := s.CallRawContext(ctx, "UpdateChecker.getProgressStatus", nil)
 	if err != nil {
 		return 0, err
 	}
@@ -120,10 +148,15 @@ func (s *ServerConnection) UpdateCheckerGetProgressStatus() (int, error) {
 // METHODS ONLY FOR LINUX VERSION
 //  checkVersionType -
 func (s *ServerConnection) UpdateCheckerDownload(checkVersionType CheckVersionType) error {
+	return s.UpdateCheckerDownloadContext(context.Background(), checkVersionType)
+}
+
+// UpdateCheckerDownloadContext - UpdateCheckerDownload with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerDownloadContext(ctx context.Context, checkVersionType CheckVersionType) error {
 	params := struct {
 		CheckVersionType CheckVersionType `json:"checkVersionType"`
 	}{checkVersionType}
-	_, err := s.CallRaw("UpdateChecker.download", params)
+	_, err := s.CallRawContext(ctx, "UpdateChecker.download", params)
 	return err
 }
 
@@ -133,10 +166,15 @@ func (s *ServerConnection) UpdateCheckerDownload(checkVersionType CheckVersionTy
 // Return
 //	id - an id obtained from fileId (same values);
 func (s *ServerConnection) UpdateCheckerUploadImage(fileId string) (*KId, error) {
+	return s.UpdateCheckerUploadImageContext(context.Background(), fileId)
+}
+
+// UpdateCheckerUploadImageContext - UpdateCheckerUploadImage with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerUploadImageContext(ctx context.Context, fileId string) (*KId, error) {
 	params := struct {
 		FileId string `json:"fileId"`
 	}{fileId}
-	data, err := s.CallRaw("UpdateChecker.uploadImage", params)
+	data, err := s.CallRawContext(ctx, "UpdateChecker.uploadImage", params)
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +191,15 @@ func (s *ServerConnection) UpdateCheckerUploadImage(fileId string) (*KId, error)
 // In case of return true, it reboots machine (after 2s delay)
 // METHODS ONLY FOR LINUX VERSION
 func (s *ServerConnection) UpdateCheckerPerformCustomUpgrade(id KId) error {
+	return s.UpdateCheckerPerformCustomUpgradeContext(context.Background(), id)
+}
+
+// UpdateCheckerPerformCustomUpgradeContext - UpdateCheckerPerformCustomUpgrade with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerPerformCustomUpgradeContext(ctx context.Context, id KId) error {
 	params := struct {
 		Id KId `json:"id"`
 	}{id}
-	_, err := s.CallRaw("UpdateChecker.performCustomUpgrade", params)
+	_, err := s.CallRawContext(ctx, "UpdateChecker.performCustomUpgrade", params)
 	return err
 }
 
@@ -164,13 +207,23 @@ func (s *ServerConnection) UpdateCheckerPerformCustomUpgrade(id KId) error {
 // In case of return true, it reboots machine (after 2s delay)
 // METHODS ONLY FOR LINUX VERSION
 func (s *ServerConnection) UpdateCheckerPerformUpgrade() error {
-	_, err := s.CallRaw("UpdateChecker.performUpgrade", nil)
+	return s.UpdateCheckerPerformUpgradeContext(context.Background())
+}
+
+// UpdateCheckerPerformUpgradeContext - UpdateCheckerPerformUpgrade with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerPerformUpgradeContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "UpdateChecker.performUpgrade", nil)
 	return err
 }
 
 // UpdateCheckerCancelDownload - Stops Downloading
 // METHODS ONLY FOR LINUX VERSION
 func (s *ServerConnection) UpdateCheckerCancelDownload() error {
-	_, err := s.CallRaw("UpdateChecker.cancelDownload", nil)
+	return s.UpdateCheckerCancelDownloadContext(context.Background())
+}
+
+// UpdateCheckerCancelDownloadContext - UpdateCheckerCancelDownload with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UpdateCheckerCancelDownloadContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "UpdateChecker.cancelDownload", nil)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UrlEntryType string
 
@@ -43,11 +46,16 @@ type UrlGroupList []UrlGroup
 //	list - list of groups and it's details
 //	totalItems - count of all groups on server (before the start/limit applied)
 func (s *ServerConnection) UrlGroupsGet(query SearchQuery) (UrlEntryList, int, error) {
+	return s.UrlGroupsGetContext(context.Background(), query)
+}
+
+// UrlGroupsGetContext - UrlGroupsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsGetContext(ctx context.Context, query SearchQuery) (UrlEntryList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query SearchQuery `json:"query"`
 	}{query}
-	data, err := s.CallRaw("UrlGroups.get", params)
+	data, err := s.CallRawContext(ctx, "UrlGroups.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -67,10 +75,15 @@ func (s *ServerConnection) UrlGroupsGet(query SearchQuery) (UrlEntryList, int, e
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) UrlGroupsCreate(groups UrlEntryList) (ErrorList, CreateResultList, error) {
+	return s.UrlGroupsCreateContext(context.Background(), groups)
+}
+
+// UrlGroupsCreateContext - UrlGroupsCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsCreateContext(ctx context.Context, groups UrlEntryList) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Groups UrlEntryList `json:"groups"`
 	}{groups}
-	data, err := s.CallRaw("UrlGroups.create", params)
+	data, err := s.CallRawContext(ctx, "UrlGroups.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,11 +103,16 @@ func (s *ServerConnection) UrlGroupsCreate(groups UrlEntryList) (ErrorList, Crea
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UrlGroupsSet(groupIds StringList, details UrlEntry) (ErrorList, error) {
+	return s.UrlGroupsSetContext(context.Background(), groupIds, details)
+}
+
+// UrlGroupsSetContext - UrlGroupsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsSetContext(ctx context.Context, groupIds StringList, details UrlEntry) (ErrorList, error) {
 	params := struct {
 		GroupIds StringList `json:"groupIds"`
 		Details  UrlEntry   `json:"details"`
 	}{groupIds, details}
-	data, err := s.CallRaw("UrlGroups.set", params)
+	data, err := s.CallRawContext(ctx, "UrlGroups.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -112,10 +130,15 @@ func (s *ServerConnection) UrlGroupsSet(groupIds StringList, details UrlEntry) (
 // Return
 //	errors - list of errors TODO write particular errors
 func (s *ServerConnection) UrlGroupsRemove(groupIds StringList) (ErrorList, error) {
+	return s.UrlGroupsRemoveContext(context.Background(), groupIds)
+}
+
+// UrlGroupsRemoveContext - UrlGroupsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsRemoveContext(ctx context.Context, groupIds StringList) (ErrorList, error) {
 	params := struct {
 		GroupIds StringList `json:"groupIds"`
 	}{groupIds}
-	data, err := s.CallRaw("UrlGroups.remove", params)
+	data, err := s.CallRawContext(ctx, "UrlGroups.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +155,12 @@ func (s *ServerConnection) UrlGroupsRemove(groupIds StringList) (ErrorList, erro
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UrlGroupsApply() (ErrorList, error) {
-	data, err := s.CallRaw("UrlGroups.apply", nil)
+	return s.UrlGroupsApplyContext(context.Background())
+}
+
+// UrlGroupsApplyContext - UrlGroupsApply with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsApplyContext(ctx context.Context) (ErrorList, error) {
+	data, err := s.CallRawContext(ctx, "UrlGroups.apply", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +175,23 @@ func (s *ServerConnection) UrlGroupsApply() (ErrorList, error) {
 
 // UrlGroupsReset - Discard changes cached in manager
 func (s *ServerConnection) UrlGroupsReset() error {
-	_, err := s.CallRaw("UrlGroups.reset", nil)
+	return s.UrlGroupsResetContext(context.Background())
+}
+
+// UrlGroupsResetContext - UrlGroupsReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "UrlGroups.reset", nil)
 	return err
 }
 
 // UrlGroupsGetGroupList - Get the list of groups, sorted in asc order
 func (s *ServerConnection) UrlGroupsGetGroupList() (UrlGroupList, error) {
-	data, err := s.CallRaw("UrlGroups.getGroupList", nil)
+	return s.UrlGroupsGetGroupListContext(context.Background())
+}
+
+// UrlGroupsGetGroupListContext - UrlGroupsGetGroupList with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UrlGroupsGetGroupListContext(ctx context.Context) (UrlGroupList, error) {
+	data, err := s.CallRawContext(ctx, "UrlGroups.getGroupList", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UserGroup struct {
 	Id          KId               `json:"id"`
@@ -19,12 +22,17 @@ type UserGroupList []UserGroup
 //	list - list of groups and it's details
 //	totalItems - count of all groups on server (before the start/limit applied)
 func (s *ServerConnection) UserGroupsGet(query SearchQuery, domainId KId) (UserGroupList, int, error) {
+	return s.UserGroupsGetContext(context.Background(), query, domainId)
+}
+
+// UserGroupsGetContext - UserGroupsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserGroupsGetContext(ctx context.Context, query SearchQuery, domainId KId) (UserGroupList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query    SearchQuery `json:"query"`
 		DomainId KId         `json:"domainId"`
 	}{query, domainId}
-	data, err := s.CallRaw("UserGroups.get", params)
+	data, err := s.CallRawContext(ctx, "UserGroups.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -45,11 +53,16 @@ func (s *ServerConnection) UserGroupsGet(query SearchQuery, domainId KId) (UserG
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) UserGroupsCreate(groups UserGroupList, domainId KId) (ErrorList, CreateResultList, error) {
+	return s.UserGroupsCreateContext(context.Background(), groups, domainId)
+}
+
+// UserGroupsCreateContext - UserGroupsCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserGroupsCreateContext(ctx context.Context, groups UserGroupList, domainId KId) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Groups   UserGroupList `json:"groups"`
 		DomainId KId           `json:"domainId"`
 	}{groups, domainId}
-	data, err := s.CallRaw("UserGroups.create", params)
+	data, err := s.CallRawContext(ctx, "UserGroups.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,12 +83,17 @@ func (s *ServerConnection) UserGroupsCreate(groups UserGroupList, domainId KId)
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UserGroupsSet(groupIds StringList, details UserGroup, domainId KId) (ErrorList, error) {
+	return s.UserGroupsSetContext(context.Background(), groupIds, details, domainId)
+}
+
+// UserGroupsSetContext - UserGroupsSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserGroupsSetContext(ctx context.Context, groupIds StringList, details UserGroup, domainId KId) (ErrorList, error) {
 	params := struct {
 		GroupIds StringList `json:"groupIds"`
 		Details  UserGroup  `json:"details"`
 		DomainId KId        `json:"domainId"`
 	}{groupIds, details, domainId}
-	data, err := s.CallRaw("UserGroups.set", params)
+	data, err := s.CallRawContext(ctx, "UserGroups.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -94,11 +112,16 @@ func (s *ServerConnection) UserGroupsSet(groupIds StringList, details UserGroup,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UserGroupsRemove(groupIds StringList, domainId KId) (ErrorList, error) {
+	return s.UserGroupsRemoveContext(context.Background(), groupIds, domainId)
+}
+
+// UserGroupsRemoveContext - UserGroupsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserGroupsRemoveContext(ctx context.Context, groupIds StringList, domainId KId) (ErrorList, error) {
 	params := struct {
 		GroupIds StringList `json:"groupIds"`
 		DomainId KId        `json:"domainId"`
 	}{groupIds, domainId}
-	data, err := s.CallRaw("UserGroups.remove", params)
+	data, err := s.CallRawContext(ctx, "UserGroups.remove", params)
 	if err != nil {
 		return nil, err
 	}
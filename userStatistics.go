@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UserStatisticType string
 
@@ -29,12 +32,17 @@ type UserStatisticList []UserStatistic
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) UserStatisticsGet(query SearchQuery, refresh bool) (UserStatisticList, int, error) {
+	return s.UserStatisticsGetContext(context.Background(), query, refresh)
+}
+
+// UserStatisticsGetContext - UserStatisticsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserStatisticsGetContext(ctx context.Context, query SearchQuery, refresh bool) (UserStatisticList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query   SearchQuery `json:"query"`
 		Refresh bool        `json:"refresh"`
 	}{query, refresh}
-	data, err := s.CallRaw("UserStatistics.get", params)
+	data, err := s.CallRawContext(ctx, "UserStatistics.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -51,9 +59,14 @@ func (s *ServerConnection) UserStatisticsGet(query SearchQuery, refresh bool) (U
 // UserStatisticsRemove - Deletes user traffic counters
 //	ids - list of user ids returned in user member by get
 func (s *ServerConnection) UserStatisticsRemove(ids KIdList) error {
+	return s.UserStatisticsRemoveContext(context.Background(), ids)
+}
+
+// UserStatisticsRemoveContext - UserStatisticsRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserStatisticsRemoveContext(ctx context.Context, ids KIdList) error {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	_, err := s.CallRaw("UserStatistics.remove", params)
+	_, err := s.CallRawContext(ctx, "UserStatistics.remove", params)
 	return err
 }
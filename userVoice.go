@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // UserVoiceSettings - Settings of UserVoice
 type UserVoiceSettings struct {
@@ -12,7 +15,12 @@ type UserVoiceSettings struct {
 // Return
 //	url - URL to userVoice with single sign on token
 func (s *ServerConnection) UserVoiceGetUrl() (string, error) {
-	data, err := s.CallRaw("UserVoice.getUrl", nil)
+	return s.UserVoiceGetUrlContext(context.Background())
+}
+
+// UserVoiceGetUrlContext - UserVoiceGetUrl with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserVoiceGetUrlContext(ctx context.Context) (string, error) {
+	data, err := s.CallRawContext(ctx, "UserVoice.getUrl", nil)
 	if err != nil {
 		return "", err
 	}
@@ -28,9 +36,14 @@ func (s *ServerConnection) UserVoiceGetUrl() (string, error) {
 // UserVoiceSet - Set settings of User Voice.
 //	settings - structure with settings
 func (s *ServerConnection) UserVoiceSet(settings UserVoiceSettings) error {
+	return s.UserVoiceSetContext(context.Background(), settings)
+}
+
+// UserVoiceSetContext - UserVoiceSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UserVoiceSetContext(ctx context.Context, settings UserVoiceSettings) error {
 	params := struct {
 		Settings UserVoiceSettings `json:"settings"`
 	}{settings}
-	_, err := s.CallRaw("UserVoice.set", params)
+	_, err := s.CallRawContext(ctx, "UserVoice.set", params)
 	return err
 }
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type UserConditionType string
 
@@ -154,12 +157,17 @@ type UserList []User
 //	list - list of users and it's details
 //	totalItems - count of all users on server (before the start/limit applied)
 func (s *ServerConnection) UsersGet(query SearchQuery, domainId KId) (ErrorList, UserList, int, error) {
+	return s.UsersGetContext(context.Background(), query, domainId)
+}
+
+// UsersGetContext - UsersGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersGetContext(ctx context.Context, query SearchQuery, domainId KId) (ErrorList, UserList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query    SearchQuery `json:"query"`
 		DomainId KId         `json:"domainId"`
 	}{query, domainId}
-	data, err := s.CallRaw("Users.get", params)
+	data, err := s.CallRawContext(ctx, "Users.get", params)
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -181,11 +189,16 @@ func (s *ServerConnection) UsersGet(query SearchQuery, domainId KId) (ErrorList,
 //	errors - list of errors
 //	result - list of IDs assigned to each item
 func (s *ServerConnection) UsersCreate(users UserList, domainId KId) (ErrorList, CreateResultList, error) {
+	return s.UsersCreateContext(context.Background(), users, domainId)
+}
+
+// UsersCreateContext - UsersCreate with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersCreateContext(ctx context.Context, users UserList, domainId KId) (ErrorList, CreateResultList, error) {
 	params := struct {
 		Users    UserList `json:"users"`
 		DomainId KId      `json:"domainId"`
 	}{users, domainId}
-	data, err := s.CallRaw("Users.create", params)
+	data, err := s.CallRawContext(ctx, "Users.create", params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -206,12 +219,17 @@ func (s *ServerConnection) UsersCreate(users UserList, domainId KId) (ErrorList,
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UsersSet(userIds KIdList, details User, domainId KId) (ErrorList, error) {
+	return s.UsersSetContext(context.Background(), userIds, details, domainId)
+}
+
+// UsersSetContext - UsersSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersSetContext(ctx context.Context, userIds KIdList, details User, domainId KId) (ErrorList, error) {
 	params := struct {
 		UserIds  KIdList `json:"userIds"`
 		Details  User    `json:"details"`
 		DomainId KId     `json:"domainId"`
 	}{userIds, details, domainId}
-	data, err := s.CallRaw("Users.set", params)
+	data, err := s.CallRawContext(ctx, "Users.set", params)
 	if err != nil {
 		return nil, err
 	}
@@ -230,11 +248,16 @@ func (s *ServerConnection) UsersSet(userIds KIdList, details User, domainId KId)
 // Return
 //	errors - list of errors
 func (s *ServerConnection) UsersRemove(userIds KIdList, domainId KId) (ErrorList, error) {
+	return s.UsersRemoveContext(context.Background(), userIds, domainId)
+}
+
+// UsersRemoveContext - UsersRemove with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersRemoveContext(ctx context.Context, userIds KIdList, domainId KId) (ErrorList, error) {
 	params := struct {
 		UserIds  KIdList `json:"userIds"`
 		DomainId KId     `json:"domainId"`
 	}{userIds, domainId}
-	data, err := s.CallRaw("Users.remove", params)
+	data, err := s.CallRawContext(ctx, "Users.remove", params)
 	if err != nil {
 		return nil, err
 	}
@@ -250,10 +273,15 @@ func (s *ServerConnection) UsersRemove(userIds KIdList, domainId KId) (ErrorList
 // UsersConvertLocalUsers - Replace all conflicting local users with domain users in policies
 //	domainId - id of domain - specifies domain, from which users will be loaded
 func (s *ServerConnection) UsersConvertLocalUsers(domainId KId) error {
+	return s.UsersConvertLocalUsersContext(context.Background(), domainId)
+}
+
+// UsersConvertLocalUsersContext - UsersConvertLocalUsers with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersConvertLocalUsersContext(ctx context.Context, domainId KId) error {
 	params := struct {
 		DomainId KId `json:"domainId"`
 	}{domainId}
-	_, err := s.CallRaw("Users.convertLocalUsers", params)
+	_, err := s.CallRawContext(ctx, "Users.convertLocalUsers", params)
 	return err
 }
 
@@ -265,13 +293,18 @@ func (s *ServerConnection) UsersConvertLocalUsers(domainId KId) error {
 // Return
 //	users - list of users and details
 func (s *ServerConnection) UsersGetAdUsers(domainName string, server string, credentials CredentialsConfig, ldapSecure bool) (UserList, error) {
+	return s.UsersGetAdUsersContext(context.Background(), domainName, server, credentials, ldapSecure)
+}
+
+// UsersGetAdUsersContext - UsersGetAdUsers with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersGetAdUsersContext(ctx context.Context, domainName string, server string, credentials CredentialsConfig, ldapSecure bool) (UserList, error) {
 	params := struct {
 		DomainName  string            `json:"domainName"`
 		Server      string            `json:"server"`
 		Credentials CredentialsConfig `json:"credentials"`
 		LdapSecure  bool              `json:"ldapSecure"`
 	}{domainName, server, credentials, ldapSecure}
-	data, err := s.CallRaw("Users.getAdUsers", params)
+	data, err := s.CallRawContext(ctx, "Users.getAdUsers", params)
 	if err != nil {
 		return nil, err
 	}
@@ -289,10 +322,15 @@ func (s *ServerConnection) UsersGetAdUsers(domainName string, server string, cre
 // Return
 //	users - list of users and details
 func (s *ServerConnection) UsersGetNtUsers(domainName string) (UserList, error) {
+	return s.UsersGetNtUsersContext(context.Background(), domainName)
+}
+
+// UsersGetNtUsersContext - UsersGetNtUsers with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersGetNtUsersContext(ctx context.Context, domainName string) (UserList, error) {
 	params := struct {
 		DomainName string `json:"domainName"`
 	}{domainName}
-	data, err := s.CallRaw("Users.getNtUsers", params)
+	data, err := s.CallRawContext(ctx, "Users.getNtUsers", params)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +347,12 @@ func (s *ServerConnection) UsersGetNtUsers(domainName string) (UserList, error)
 // Return
 //	languages - list of languages
 func (s *ServerConnection) UsersGetSupportedLanguages() (NamedValueList, error) {
-	data, err := s.CallRaw("Users.getSupportedLanguages", nil)
+	return s.UsersGetSupportedLanguagesContext(context.Background())
+}
+
+// UsersGetSupportedLanguagesContext - UsersGetSupportedLanguages with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersGetSupportedLanguagesContext(ctx context.Context) (NamedValueList, error) {
+	data, err := s.CallRawContext(ctx, "Users.getSupportedLanguages", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +369,12 @@ func (s *ServerConnection) UsersGetSupportedLanguages() (NamedValueList, error)
 // Return
 //	settings - list of all settings
 func (s *ServerConnection) UsersGetMySettings() (*UserSettings, error) {
-	data, err := s.CallRaw("Users.getMySettings", nil)
+	return s.UsersGetMySettingsContext(context.Background())
+}
+
+// UsersGetMySettingsContext - UsersGetMySettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersGetMySettingsContext(ctx context.Context) (*UserSettings, error) {
+	data, err := s.CallRawContext(ctx, "Users.getMySettings", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -342,10 +390,15 @@ func (s *ServerConnection) UsersGetMySettings() (*UserSettings, error) {
 // UsersSetMySettings - Stores list of user's settings
 //	settings - list of all settings
 func (s *ServerConnection) UsersSetMySettings(settings UserSettings) error {
+	return s.UsersSetMySettingsContext(context.Background(), settings)
+}
+
+// UsersSetMySettingsContext - UsersSetMySettings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersSetMySettingsContext(ctx context.Context, settings UserSettings) error {
 	params := struct {
 		Settings UserSettings `json:"settings"`
 	}{settings}
-	_, err := s.CallRaw("Users.setMySettings", params)
+	_, err := s.CallRawContext(ctx, "Users.setMySettings", params)
 	return err
 }
 
@@ -354,10 +407,15 @@ func (s *ServerConnection) UsersSetMySettings(settings UserSettings) error {
 // Return
 //	errors - list of all warnings
 func (s *ServerConnection) UsersCheckWarnings(user User) (ErrorList, error) {
+	return s.UsersCheckWarningsContext(context.Background(), user)
+}
+
+// UsersCheckWarningsContext - UsersCheckWarnings with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) UsersCheckWarningsContext(ctx context.Context, user User) (ErrorList, error) {
 	params := struct {
 		User User `json:"user"`
 	}{user}
-	data, err := s.CallRaw("Users.checkWarnings", params)
+	data, err := s.CallRawContext(ctx, "Users.checkWarnings", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type VpnClientState string
 
@@ -44,12 +47,17 @@ type VpnClientList []VpnClientInfo
 //	list - output data
 //	totalItems - all data count
 func (s *ServerConnection) VpnClientsGet(query SearchQuery, refresh bool) (VpnClientList, int, error) {
+	return s.VpnClientsGetContext(context.Background(), query, refresh)
+}
+
+// VpnClientsGetContext - VpnClientsGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) VpnClientsGetContext(ctx context.Context, query SearchQuery, refresh bool) (VpnClientList, int, error) {
 	query = addMissedParametersToSearchQuery(query)
 	params := struct {
 		Query   SearchQuery `json:"query"`
 		Refresh bool        `json:"refresh"`
 	}{query, refresh}
-	data, err := s.CallRaw("VpnClients.get", params)
+	data, err := s.CallRawContext(ctx, "VpnClients.get", params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -66,9 +74,14 @@ func (s *ServerConnection) VpnClientsGet(query SearchQuery, refresh bool) (VpnCl
 // VpnClientsKill - Disconnects clients specified in ids list
 //  ids - IDs list
 func (s *ServerConnection) VpnClientsKill(ids KIdList) error {
+	return s.VpnClientsKillContext(context.Background(), ids)
+}
+
+// VpnClientsKillContext - VpnClientsKill with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) VpnClientsKillContext(ctx context.Context, ids KIdList) error {
 	params := struct {
 		Ids KIdList `json:"ids"`
 	}{ids}
-	_, err := s.CallRaw("VpnClients.kill", params)
+	_, err := s.CallRawContext(ctx, "VpnClients.kill", params)
 	return err
 }
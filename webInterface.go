@@ -1,6 +1,9 @@
 package control
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // Certificates (Secured Web Interface and SSL-VPN) will be handled by CertificateManager
 // SslVpnConfig - not applicable on Linux
@@ -29,7 +32,12 @@ type WebInterfaceConfig struct {
 
 // WebInterfaceGet - Returns actual values for Web Interface and Kerio Clientless SSL-VPN configuration in WebAdmin
 func (s *ServerConnection) WebInterfaceGet() (*WebInterfaceConfig, error) {
-	data, err := s.CallRaw("WebInterface.get", nil)
+	return s.WebInterfaceGetContext(context.Background())
+}
+
+// WebInterfaceGetContext - WebInterfaceGet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) WebInterfaceGetContext(ctx context.Context) (*WebInterfaceConfig, error) {
+	data, err := s.CallRawContext(ctx, "WebInterface.get", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +54,16 @@ func (s *ServerConnection) WebInterfaceGet() (*WebInterfaceConfig, error) {
 //	config - structure with settings for webinterface module
 //	revertTimeout - If client doesn't confirm config to this timeout, configuration is reverted (0 - revert disabled)
 func (s *ServerConnection) WebInterfaceSet(config WebInterfaceConfig, revertTimeout int) error {
+	return s.WebInterfaceSetContext(context.Background(), config, revertTimeout)
+}
+
+// WebInterfaceSetContext - WebInterfaceSet with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) WebInterfaceSetContext(ctx context.Context, config WebInterfaceConfig, revertTimeout int) error {
 	params := struct {
 		Config        WebInterfaceConfig `json:"config"`
 		RevertTimeout int                `json:"revertTimeout"`
 	}{config, revertTimeout}
-	_, err := s.CallRaw("WebInterface.set", params)
+	_, err := s.CallRawContext(ctx, "WebInterface.set", params)
 	return err
 }
 
@@ -58,16 +71,26 @@ func (s *ServerConnection) WebInterfaceSet(config WebInterfaceConfig, revertTime
 //	fileId - according to spec 390.
 //	isFavicon - true = the image is favicon, false = the image is product logo
 func (s *ServerConnection) WebInterfaceUploadImage(fileId string, isFavicon bool) error {
+	return s.WebInterfaceUploadImageContext(context.Background(), fileId, isFavicon)
+}
+
+// WebInterfaceUploadImageContext - WebInterfaceUploadImage with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) WebInterfaceUploadImageContext(ctx context.Context, fileId string, isFavicon bool) error {
 	params := struct {
 		FileId    string `json:"fileId"`
 		IsFavicon bool   `json:"isFavicon"`
 	}{fileId, isFavicon}
-	_, err := s.CallRaw("WebInterface.uploadImage", params)
+	_, err := s.CallRawContext(ctx, "WebInterface.uploadImage", params)
 	return err
 }
 
 // WebInterfaceReset - Discard uploaded images
 func (s *ServerConnection) WebInterfaceReset() error {
-	_, err := s.CallRaw("WebInterface.reset", nil)
+	return s.WebInterfaceResetContext(context.Background())
+}
+
+// WebInterfaceResetContext - WebInterfaceReset with a context controlling cancellation and deadline of the call
+func (s *ServerConnection) WebInterfaceResetContext(ctx context.Context) error {
+	_, err := s.CallRawContext(ctx, "WebInterface.reset", nil)
 	return err
 }
@@ -0,0 +1,238 @@
+package control
+
+import "reflect"
+
+// wireTypes - exported types sent to or received from the server, see TestWireTypesComplete
+var wireTypes = []reflect.Type{
+	reflect.TypeOf(AccountingConfig{}),
+	reflect.TypeOf(ActiveConnection{}),
+	reflect.TypeOf(ActiveHost{}),
+	reflect.TypeOf(Activity{}),
+	reflect.TypeOf(AddResult{}),
+	reflect.TypeOf(Addressee{}),
+	reflect.TypeOf(AlertLogEvent{}),
+	reflect.TypeOf(AlertRow{}),
+	reflect.TypeOf(AlertRuleEvent{}),
+	reflect.TypeOf(AlertSetting{}),
+	reflect.TypeOf(AlertType{}),
+	reflect.TypeOf(AntiHammeringConfig{}),
+	reflect.TypeOf(AntiSpoofingConfig{}),
+	reflect.TypeOf(AntivirusConfig{}),
+	reflect.TypeOf(AntivirusOption{}),
+	reflect.TypeOf(AntivirusSetting{}),
+	reflect.TypeOf(ApiApplication{}),
+	reflect.TypeOf(AuthenticationConfig{}),
+	reflect.TypeOf(AutoLogin{}),
+	reflect.TypeOf(BMCondition{}),
+	reflect.TypeOf(BMRule{}),
+	reflect.TypeOf(BandwidthManagementConfig{}),
+	reflect.TypeOf(BandwidthSetting{}),
+	reflect.TypeOf(BlackList{}),
+	reflect.TypeOf(ByteValueWithUnits{}),
+	reflect.TypeOf(CentralManagementConfig{}),
+	reflect.TypeOf(CentralManagementStatus{}),
+	reflect.TypeOf(Certificate{}),
+	reflect.TypeOf(CertificateDn{}),
+	reflect.TypeOf(ClientTimestamp{}),
+	reflect.TypeOf(Collision{}),
+	reflect.TypeOf(ConfigurationBackupConfig{}),
+	reflect.TypeOf(ConfigurationBackupStatus{}),
+	reflect.TypeOf(ConnLimitSettings{}),
+	reflect.TypeOf(ConnectionLimit{}),
+	reflect.TypeOf(ConnectionPoint{}),
+	reflect.TypeOf(ConnectivityAssistantConfig{}),
+	reflect.TypeOf(ConnectivityConfig{}),
+	reflect.TypeOf(ContentApplication{}),
+	reflect.TypeOf(ContentCondition{}),
+	reflect.TypeOf(ContentConditionEntity{}),
+	reflect.TypeOf(ContentRule{}),
+	reflect.TypeOf(CoreDump{}),
+	reflect.TypeOf(CoreDumpWithImportance{}),
+	reflect.TypeOf(CreateResult{}),
+	reflect.TypeOf(Credentials{}),
+	reflect.TypeOf(CredentialsConfig{}),
+	reflect.TypeOf(CurrentInterface{}),
+	reflect.TypeOf(CustomizedBrand{}),
+	reflect.TypeOf(DataStatistic{}),
+	reflect.TypeOf(Date{}),
+	reflect.TypeOf(DateTimeConfig{}),
+	reflect.TypeOf(DenialCondition{}),
+	reflect.TypeOf(DetailsConfig{}),
+	reflect.TypeOf(DhcpConfig{}),
+	reflect.TypeOf(DhcpExclusion{}),
+	reflect.TypeOf(DhcpLease{}),
+	reflect.TypeOf(DhcpMode{}),
+	reflect.TypeOf(DhcpOption{}),
+	reflect.TypeOf(DhcpScope{}),
+	reflect.TypeOf(DhcpScopes{}),
+	reflect.TypeOf(DirectoryService{}),
+	reflect.TypeOf(DirectoryServiceAdvanced{}),
+	reflect.TypeOf(DirectoryServiceConfiguration{}),
+	reflect.TypeOf(DnsConfig{}),
+	reflect.TypeOf(DnsForwarder{}),
+	reflect.TypeOf(DnsHost{}),
+	reflect.TypeOf(Domain{}),
+	reflect.TypeOf(Download{}),
+	reflect.TypeOf(DynamicDnsConfig{}),
+	reflect.TypeOf(EmailScanningConfig{}),
+	reflect.TypeOf(Error{}),
+	reflect.TypeOf(ErrorReport{}),
+	reflect.TypeOf(ExpireInfo{}),
+	reflect.TypeOf(ExportOptions{}),
+	reflect.TypeOf(Extension{}),
+	reflect.TypeOf(ExternalAntivirus{}),
+	reflect.TypeOf(FilenameGroup{}),
+	reflect.TypeOf(ForbiddenWord{}),
+	reflect.TypeOf(ForbiddenWordGroup{}),
+	reflect.TypeOf(ForbiddenWordsConfig{}),
+	reflect.TypeOf(GuestConfig{}),
+	reflect.TypeOf(HighlightItem{}),
+	reflect.TypeOf(Histogram{}),
+	reflect.TypeOf(HistogramData{}),
+	reflect.TypeOf(HttpCacheConfig{}),
+	reflect.TypeOf(HttpCacheStatus{}),
+	reflect.TypeOf(HttpFtpScanningConfig{}),
+	reflect.TypeOf(HttpProxyAuth{}),
+	reflect.TypeOf(HttpsConfig{}),
+	reflect.TypeOf(IPv6Config{}),
+	reflect.TypeOf(IdReference{}),
+	reflect.TypeOf(ImportedInterface{}),
+	reflect.TypeOf(Inspector{}),
+	reflect.TypeOf(Interface{}),
+	reflect.TypeOf(InterfaceCondition{}),
+	reflect.TypeOf(InterfaceConnectivityParameters{}),
+	reflect.TypeOf(InterfaceFlags{}),
+	reflect.TypeOf(InternalAntivirus{}),
+	reflect.TypeOf(InternalUpdateStatus{}),
+	reflect.TypeOf(InternetBandwidth{}),
+	reflect.TypeOf(InternetBandwidthData{}),
+	reflect.TypeOf(IntrusionPreventionConfig{}),
+	reflect.TypeOf(IntrusionPreventionInfo{}),
+	reflect.TypeOf(Ip6AddressMask{}),
+	reflect.TypeOf(IpAddressEntry{}),
+	reflect.TypeOf(IpAddressGroup{}),
+	reflect.TypeOf(IpAddressMask{}),
+	reflect.TypeOf(IpService{}),
+	reflect.TypeOf(IpServiceReference{}),
+	reflect.TypeOf(IpsecPeerIdConfig{}),
+	reflect.TypeOf(LanInterfaceConfig{}),
+	reflect.TypeOf(LicenseDetail{}),
+	reflect.TypeOf(LocalizableMessage{}),
+	reflect.TypeOf(LocalizableMessageParameters{}),
+	reflect.TypeOf(LogFileSettings{}),
+	reflect.TypeOf(LogItem{}),
+	reflect.TypeOf(LogRotationSettings{}),
+	reflect.TypeOf(LogRow{}),
+	reflect.TypeOf(LogSettings{}),
+	reflect.TypeOf(MacAccessItem{}),
+	reflect.TypeOf(MacFilterConfig{}),
+	reflect.TypeOf(ManipulationError{}),
+	reflect.TypeOf(MiscSettingsConfig{}),
+	reflect.TypeOf(NamedMultiValue{}),
+	reflect.TypeOf(NamedValue{}),
+	reflect.TypeOf(Notification{}),
+	reflect.TypeOf(NtpUpdateStatus{}),
+	reflect.TypeOf(OptionalEntity{}),
+	reflect.TypeOf(OptionalIdReference{}),
+	reflect.TypeOf(OptionalLong{}),
+	reflect.TypeOf(OptionalString{}),
+	reflect.TypeOf(OptionalStringList{}),
+	reflect.TypeOf(P2pEliminatorConfig{}),
+	reflect.TypeOf(PacketDumpStatus{}),
+	reflect.TypeOf(ParentProxyConfig{}),
+	reflect.TypeOf(Password{}),
+	reflect.TypeOf(PortCondition{}),
+	reflect.TypeOf(PortConfig{}),
+	reflect.TypeOf(ProductInfo{}),
+	reflect.TypeOf(ProductInformation{}),
+	reflect.TypeOf(ProxyServerConfig{}),
+	reflect.TypeOf(Quota{}),
+	reflect.TypeOf(QuotaInterval{}),
+	reflect.TypeOf(Radius{}),
+	reflect.TypeOf(RasConfig{}),
+	reflect.TypeOf(RegDate{}),
+	reflect.TypeOf(Registration{}),
+	reflect.TypeOf(RegistrationFullStatus{}),
+	reflect.TypeOf(RegistrationNumber{}),
+	reflect.TypeOf(RegistrationStatus{}),
+	reflect.TypeOf(ReportConfig{}),
+	reflect.TypeOf(Restriction{}),
+	reflect.TypeOf(RestrictionTuple{}),
+	reflect.TypeOf(ReverseProxyConfig{}),
+	reflect.TypeOf(ReverseProxyRule{}),
+	reflect.TypeOf(Route{}),
+	reflect.TypeOf(RouterAdvertisement{}),
+	reflect.TypeOf(RouterAdvertisementsConfig{}),
+	reflect.TypeOf(RuleReference{}),
+	reflect.TypeOf(SafeSearchConfig{}),
+	reflect.TypeOf(ScanRuleConfig{}),
+	reflect.TypeOf(ScannedProtocols{}),
+	reflect.TypeOf(SearchQuery{}),
+	reflect.TypeOf(SecuritySettingsConfig{}),
+	reflect.TypeOf(SharedDefinitionInfo{}),
+	reflect.TypeOf(SignOn{}),
+	reflect.TypeOf(SizeLimit{}),
+	reflect.TypeOf(SmtpRelayConfig{}),
+	reflect.TypeOf(SnmpSettings{}),
+	reflect.TypeOf(SortOrder{}),
+	reflect.TypeOf(SourceCondition{}),
+	reflect.TypeOf(SourceConditonEntity{}),
+	reflect.TypeOf(SslVpnConfig{}),
+	reflect.TypeOf(SslVpnScanningConfig{}),
+	reflect.TypeOf(StarReport{}),
+	reflect.TypeOf(StatisticsData{}),
+	reflect.TypeOf(StatusFunction{}),
+	reflect.TypeOf(StorageData{}),
+	reflect.TypeOf(SubCondition{}),
+	reflect.TypeOf(SurveyAnswer{}),
+	reflect.TypeOf(SyslogSettings{}),
+	reflect.TypeOf(SystemConfiguration{}),
+	reflect.TypeOf(SystemHealthData{}),
+	reflect.TypeOf(SystemInfo{}),
+	reflect.TypeOf(TestResult{}),
+	reflect.TypeOf(Time{}),
+	reflect.TypeOf(TimeHMS{}),
+	reflect.TypeOf(TimeRangeEntry{}),
+	reflect.TypeOf(TimeRangeGroup{}),
+	reflect.TypeOf(TimeSpan{}),
+	reflect.TypeOf(TimeZoneConfig{}),
+	reflect.TypeOf(TotpConfig{}),
+	reflect.TypeOf(TrafficCondition{}),
+	reflect.TypeOf(TrafficEntity{}),
+	reflect.TypeOf(TrafficPolicyFilter{}),
+	reflect.TypeOf(TrafficRule{}),
+	reflect.TypeOf(TrafficService{}),
+	reflect.TypeOf(TrafficServiceEntity{}),
+	reflect.TypeOf(TrafficStatistic{}),
+	reflect.TypeOf(TreeLeaf{}),
+	reflect.TypeOf(UpdateCheckerConfig{}),
+	reflect.TypeOf(UpdateCheckerInfo{}),
+	reflect.TypeOf(UpnpConfig{}),
+	reflect.TypeOf(UrlEntry{}),
+	reflect.TypeOf(UrlFilterConfig{}),
+	reflect.TypeOf(UrlGroup{}),
+	reflect.TypeOf(UrlSpecificTtl{}),
+	reflect.TypeOf(UrlWhiteListEntry{}),
+	reflect.TypeOf(User{}),
+	reflect.TypeOf(UserCondition{}),
+	reflect.TypeOf(UserData{}),
+	reflect.TypeOf(UserGroup{}),
+	reflect.TypeOf(UserInfo{}),
+	reflect.TypeOf(UserReference{}),
+	reflect.TypeOf(UserRights{}),
+	reflect.TypeOf(UserSettings{}),
+	reflect.TypeOf(UserStatistic{}),
+	reflect.TypeOf(UserVoiceSettings{}),
+	reflect.TypeOf(ValidPeriod{}),
+	reflect.TypeOf(VpnClientInfo{}),
+	reflect.TypeOf(VpnCondition{}),
+	reflect.TypeOf(VpnRoute{}),
+	reflect.TypeOf(VpnServerConfig{}),
+	reflect.TypeOf(VpnTunnelConfig{}),
+	reflect.TypeOf(WanInterfaceConfig{}),
+	reflect.TypeOf(WarningInfo{}),
+	reflect.TypeOf(WebInterfaceConfig{}),
+	reflect.TypeOf(WwwFilter{}),
+	reflect.TypeOf(ZeroConfigItem{}),
+	reflect.TypeOf(ZeroConfigNetwork{}),
+}
@@ -0,0 +1,348 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// jsonIdentifier - valid member name of the API
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// sources parses the non-test sources of the package
+func sources(t *testing.T) map[string]*ast.File {
+	t.Helper()
+	files := map[string]*ast.File{}
+	names, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = f
+	}
+	return files
+}
+
+// taggedStructs returns source file of every exported struct type having a json tag
+func taggedStructs(t *testing.T) map[string]string {
+	t.Helper()
+	types := map[string]string{}
+	for name, f := range sources(t) {
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() {
+				return true
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				if field.Tag != nil && strings.Contains(field.Tag.Value, `json:"`) {
+					types[ts.Name.Name] = name
+					break
+				}
+			}
+			return true
+		})
+	}
+	return types
+}
+
+// libraryTypes - exported types with json tags which are not part of the API
+var libraryTypes = map[string]bool{
+	"Cassette":    true,
+	"Interaction": true,
+}
+
+func TestWireTypesComplete(t *testing.T) {
+	listed := map[string]bool{}
+	for _, typ := range wireTypes {
+		listed[typ.Name()] = true
+	}
+	for name := range taggedStructs(t) {
+		if libraryTypes[name] {
+			if listed[name] {
+				t.Errorf("%s is not an API type, remove it from wireTypes", name)
+			}
+			continue
+		}
+		if !listed[name] {
+			t.Errorf("%s is missing in wireTypes", name)
+		}
+	}
+}
+
+func TestWireTags(t *testing.T) {
+	checked := map[reflect.Type]bool{}
+	for _, typ := range wireTypes {
+		checkTags(t, typ, checked)
+	}
+}
+
+// checkTags reports fields of typ and of the types it refers to with json tags not valid on the wire
+func checkTags(t *testing.T, typ reflect.Type, checked map[reflect.Type]bool) {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || checked[typ] {
+		return
+	}
+	checked[typ] = true
+	names := map[string]string{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag, ok := field.Tag.Lookup("json")
+		if !ok {
+			if !field.Anonymous {
+				t.Errorf("%s.%s has no json tag", typ.Name(), field.Name)
+			}
+			checkTags(t, field.Type, checked)
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		if !jsonIdentifier.MatchString(parts[0]) {
+			t.Errorf("%s.%s has invalid json name %q", typ.Name(), field.Name, parts[0])
+		}
+		for _, option := range parts[1:] {
+			if option != "omitempty" && option != "string" {
+				t.Errorf("%s.%s has unknown json option %q", typ.Name(), field.Name, option)
+			}
+		}
+		if other, ok := names[parts[0]]; ok {
+			t.Errorf("%s.%s and %s.%s have the same json name %q", typ.Name(), other, typ.Name(), field.Name, parts[0])
+		}
+		names[parts[0]] = field.Name
+		checkTags(t, field.Type, checked)
+	}
+}
+
+// jsonEqual reports whether a and b encode the same JSON value
+func jsonEqual(a, b []byte) (bool, error) {
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(va, vb), nil
+}
+
+// TestWireSamples decodes payloads in testdata/wire, written by hand as the server sends them, into the types named by the files.
+// Every member of a sample must be known to the type and every field of the type must be present in the sample.
+func TestWireSamples(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "wire", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no samples found")
+	}
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		typ := wireType(name)
+		if typ == nil {
+			t.Errorf("%s: %s is not in wireTypes", path, name)
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		v := reflect.New(typ)
+		if err = decoder.Decode(v.Interface()); err != nil {
+			t.Errorf("%s: %v", path, err)
+			continue
+		}
+		encoded, err := json.Marshal(v.Elem().Interface())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		equal, err := jsonEqual(data, encoded)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if !equal {
+			compacted := &bytes.Buffer{}
+			_ = json.Compact(compacted, data)
+			t.Errorf("%s: %s does not survive round trip\nexpected: %s\ngot:      %s", path, name, compacted, encoded)
+		}
+	}
+}
+
+func wireType(name string) reflect.Type {
+	for _, typ := range wireTypes {
+		if typ.Name() == name {
+			return typ
+		}
+	}
+	return nil
+}
+
+// wrapper - method of ServerConnection calling a method of the API
+type wrapper struct {
+	name   string // name of the Go method, e.g. "UsersGetContext"
+	method string // method of the API, e.g. "Users.get"
+}
+
+// wrappers returns Context variants of methods calling exactly one method of the API
+func wrappers(t *testing.T) []wrapper {
+	t.Helper()
+	var list []wrapper
+	for _, f := range sources(t) {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || !strings.HasSuffix(fd.Name.Name, "Context") || fd.Name.Name == "CallRawContext" {
+				continue
+			}
+			var methods []string
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) < 2 {
+					return true
+				}
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "CallRawContext" {
+					if lit, ok := call.Args[1].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						method, _ := strconv.Unquote(lit.Value)
+						methods = append(methods, method)
+					}
+				}
+				return true
+			})
+			if len(methods) != 1 {
+				continue
+			}
+			list = append(list, wrapper{name: fd.Name.Name, method: methods[0]})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	return list
+}
+
+var errCaptured = errors.New("captured")
+
+// apiParams reads testdata/wire/params.txt listing names of parameters of the API methods taken from the IDL
+func apiParams(t *testing.T) map[string][]string {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "wire", "params.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods := map[string][]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if _, ok := methods[fields[0]]; ok {
+			t.Fatalf("params.txt: %s listed twice", fields[0])
+		}
+		methods[fields[0]] = fields[1:]
+	}
+	return methods
+}
+
+func TestWireParams(t *testing.T) {
+	var method string
+	var params interface{}
+	s := &ServerConnection{Config: NewConfig("localhost")}
+	s.Use(func(RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(_ context.Context, m string, p interface{}) ([]byte, error) {
+			method, params = m, p
+			return nil, errCaptured
+		})
+	})
+	list := wrappers(t)
+	if len(list) == 0 {
+		t.Fatal("no wrappers found")
+	}
+	methods := apiParams(t)
+	called := map[string]bool{}
+	for _, w := range list {
+		fn := reflect.ValueOf(s).MethodByName(w.name)
+		args := []reflect.Value{reflect.ValueOf(context.Background())}
+		for i := 1; i < fn.Type().NumIn(); i++ {
+			args = append(args, reflect.New(fn.Type().In(i)).Elem())
+		}
+		method, params = "", nil
+		fn.Call(args)
+		if method != w.method {
+			t.Errorf("%s calls %q, expected %q", w.name, method, w.method)
+			continue
+		}
+		sent, err := paramNames(params)
+		if err != nil {
+			t.Errorf("%s: %v", w.name, err)
+			continue
+		}
+		called[method] = true
+		documented, ok := methods[method]
+		if !ok {
+			t.Errorf("%s: %s is missing in testdata/wire/params.txt", w.name, method)
+			continue
+		}
+		expected := append([]string{}, documented...)
+		sort.Strings(expected)
+		if strings.Join(sent, ",") != strings.Join(expected, ",") {
+			t.Errorf("%s sends params %v, expected %v", w.name, sent, expected)
+		}
+	}
+	for method := range methods {
+		if !called[method] {
+			t.Errorf("no wrapper calls %s listed in testdata/wire/params.txt", method)
+		}
+	}
+}
+
+// paramNames returns sorted names of members of encoded params
+func paramNames(params interface{}) ([]string, error) {
+	if params == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	members := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}